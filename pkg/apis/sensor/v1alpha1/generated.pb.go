@@ -45,7 +45,7 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{0}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{0}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -73,7 +73,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ConfigmapArtifact) Reset()      { *m = ConfigmapArtifact{} }
 func (*ConfigmapArtifact) ProtoMessage() {}
 func (*ConfigmapArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{1}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{1}
 }
 func (m *ConfigmapArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -101,7 +101,7 @@ var xxx_messageInfo_ConfigmapArtifact proto.InternalMessageInfo
 func (m *DataFilter) Reset()      { *m = DataFilter{} }
 func (*DataFilter) ProtoMessage() {}
 func (*DataFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{2}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{2}
 }
 func (m *DataFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,7 +129,7 @@ var xxx_messageInfo_DataFilter proto.InternalMessageInfo
 func (m *DependencyGroup) Reset()      { *m = DependencyGroup{} }
 func (*DependencyGroup) ProtoMessage() {}
 func (*DependencyGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{3}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{3}
 }
 func (m *DependencyGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +157,7 @@ var xxx_messageInfo_DependencyGroup proto.InternalMessageInfo
 func (m *EventDependency) Reset()      { *m = EventDependency{} }
 func (*EventDependency) ProtoMessage() {}
 func (*EventDependency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{4}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{4}
 }
 func (m *EventDependency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,7 +185,7 @@ var xxx_messageInfo_EventDependency proto.InternalMessageInfo
 func (m *EventDependencyFilter) Reset()      { *m = EventDependencyFilter{} }
 func (*EventDependencyFilter) ProtoMessage() {}
 func (*EventDependencyFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{5}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{5}
 }
 func (m *EventDependencyFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +213,7 @@ var xxx_messageInfo_EventDependencyFilter proto.InternalMessageInfo
 func (m *FileArtifact) Reset()      { *m = FileArtifact{} }
 func (*FileArtifact) ProtoMessage() {}
 func (*FileArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{6}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{6}
 }
 func (m *FileArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +241,7 @@ var xxx_messageInfo_FileArtifact proto.InternalMessageInfo
 func (m *GroupVersionKind) Reset()      { *m = GroupVersionKind{} }
 func (*GroupVersionKind) ProtoMessage() {}
 func (*GroupVersionKind) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{7}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{7}
 }
 func (m *GroupVersionKind) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +269,7 @@ var xxx_messageInfo_GroupVersionKind proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{8}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{8}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +297,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *ResourceObject) Reset()      { *m = ResourceObject{} }
 func (*ResourceObject) ProtoMessage() {}
 func (*ResourceObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{9}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{9}
 }
 func (m *ResourceObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +325,7 @@ var xxx_messageInfo_ResourceObject proto.InternalMessageInfo
 func (m *ResourceParameter) Reset()      { *m = ResourceParameter{} }
 func (*ResourceParameter) ProtoMessage() {}
 func (*ResourceParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{10}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{10}
 }
 func (m *ResourceParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +353,7 @@ var xxx_messageInfo_ResourceParameter proto.InternalMessageInfo
 func (m *ResourceParameterSource) Reset()      { *m = ResourceParameterSource{} }
 func (*ResourceParameterSource) ProtoMessage() {}
 func (*ResourceParameterSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{11}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{11}
 }
 func (m *ResourceParameterSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +381,7 @@ var xxx_messageInfo_ResourceParameterSource proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{12}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{12}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +409,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *Sensor) Reset()      { *m = Sensor{} }
 func (*Sensor) ProtoMessage() {}
 func (*Sensor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{13}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{13}
 }
 func (m *Sensor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +437,7 @@ var xxx_messageInfo_Sensor proto.InternalMessageInfo
 func (m *SensorList) Reset()      { *m = SensorList{} }
 func (*SensorList) ProtoMessage() {}
 func (*SensorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{14}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{14}
 }
 func (m *SensorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +465,7 @@ var xxx_messageInfo_SensorList proto.InternalMessageInfo
 func (m *SensorSpec) Reset()      { *m = SensorSpec{} }
 func (*SensorSpec) ProtoMessage() {}
 func (*SensorSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{15}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{15}
 }
 func (m *SensorSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +493,7 @@ var xxx_messageInfo_SensorSpec proto.InternalMessageInfo
 func (m *SensorStatus) Reset()      { *m = SensorStatus{} }
 func (*SensorStatus) ProtoMessage() {}
 func (*SensorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{16}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{16}
 }
 func (m *SensorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,10 +518,38 @@ func (m *SensorStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SensorStatus proto.InternalMessageInfo
 
+func (m *TarballArtifact) Reset()      { *m = TarballArtifact{} }
+func (*TarballArtifact) ProtoMessage() {}
+func (*TarballArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{17}
+}
+func (m *TarballArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TarballArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalTo(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (dst *TarballArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TarballArtifact.Merge(dst, src)
+}
+func (m *TarballArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *TarballArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_TarballArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TarballArtifact proto.InternalMessageInfo
+
 func (m *TimeFilter) Reset()      { *m = TimeFilter{} }
 func (*TimeFilter) ProtoMessage() {}
 func (*TimeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{17}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{18}
 }
 func (m *TimeFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +577,7 @@ var xxx_messageInfo_TimeFilter proto.InternalMessageInfo
 func (m *Trigger) Reset()      { *m = Trigger{} }
 func (*Trigger) ProtoMessage() {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{18}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{19}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,7 +605,7 @@ var xxx_messageInfo_Trigger proto.InternalMessageInfo
 func (m *TriggerCondition) Reset()      { *m = TriggerCondition{} }
 func (*TriggerCondition) ProtoMessage() {}
 func (*TriggerCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{19}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{20}
 }
 func (m *TriggerCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -605,7 +633,7 @@ var xxx_messageInfo_TriggerCondition proto.InternalMessageInfo
 func (m *URLArtifact) Reset()      { *m = URLArtifact{} }
 func (*URLArtifact) ProtoMessage() {}
 func (*URLArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_generated_00c8a4131a6a39b4, []int{20}
+	return fileDescriptor_generated_5e8d88b6ac345da4, []int{21}
 }
 func (m *URLArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,6 +678,7 @@ func init() {
 	proto.RegisterType((*SensorSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorSpec")
 	proto.RegisterType((*SensorStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus")
 	proto.RegisterMapType((map[string]NodeStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus.NodesEntry")
+	proto.RegisterType((*TarballArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TarballArtifact")
 	proto.RegisterType((*TimeFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TimeFilter")
 	proto.RegisterType((*Trigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Trigger")
 	proto.RegisterType((*TriggerCondition)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerCondition")
@@ -716,6 +745,16 @@ func (m *ArtifactLocation) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.Tarball != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Tarball.Size()))
+		n5, err := m.Tarball.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -852,11 +891,11 @@ func (m *EventDependency) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Filters.Size()))
-	n5, err := m.Filters.MarshalTo(dAtA[i:])
+	n6, err := m.Filters.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	dAtA[i] = 0x20
 	i++
 	if m.Connected {
@@ -891,21 +930,21 @@ func (m *EventDependencyFilter) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Time.Size()))
-		n6, err := m.Time.MarshalTo(dAtA[i:])
+		n7, err := m.Time.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if m.Context != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Context.Size()))
-		n7, err := m.Context.MarshalTo(dAtA[i:])
+		n8, err := m.Context.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if len(m.Data) > 0 {
 		for _, msg := range m.Data {
@@ -1012,19 +1051,19 @@ func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n8, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n9, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.CompletedAt.Size()))
-	n9, err := m.CompletedAt.MarshalTo(dAtA[i:])
+	n10, err := m.CompletedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
@@ -1033,11 +1072,11 @@ func (m *NodeStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Event.Size()))
-		n10, err := m.Event.MarshalTo(dAtA[i:])
+		n11, err := m.Event.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	return i, nil
 }
@@ -1098,19 +1137,19 @@ func (m *ResourceObject) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.GroupVersionKind.Size()))
-	n11, err := m.GroupVersionKind.MarshalTo(dAtA[i:])
+	n12, err := m.GroupVersionKind.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n12, err := m.Source.MarshalTo(dAtA[i:])
+	n13, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	return i, nil
 }
 
@@ -1133,11 +1172,11 @@ func (m *ResourceParameter) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Src.Size()))
-		n13, err := m.Src.MarshalTo(dAtA[i:])
+		n14, err := m.Src.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	dAtA[i] = 0x12
 	i++
@@ -1214,27 +1253,27 @@ func (m *Sensor) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ObjectMeta.Size()))
-	n14, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	n15, err := m.ObjectMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Spec.Size()))
-	n15, err := m.Spec.MarshalTo(dAtA[i:])
+	n16, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Status.Size()))
-	n16, err := m.Status.MarshalTo(dAtA[i:])
+	n17, err := m.Status.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	return i, nil
 }
 
@@ -1256,11 +1295,11 @@ func (m *SensorList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n17, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n18, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -1319,21 +1358,21 @@ func (m *SensorSpec) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.DeploySpec.Size()))
-		n18, err := m.DeploySpec.MarshalTo(dAtA[i:])
+		n19, err := m.DeploySpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	if m.EventProtocol != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.EventProtocol.Size()))
-		n19, err := m.EventProtocol.MarshalTo(dAtA[i:])
+		n20, err := m.EventProtocol.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n20
 	}
 	dAtA[i] = 0x2a
 	i++
@@ -1376,19 +1415,19 @@ func (m *SensorStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n20, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n21, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n21
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.CompletedAt.Size()))
-	n21, err := m.CompletedAt.MarshalTo(dAtA[i:])
+	n22, err := m.CompletedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n22
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
@@ -1417,11 +1456,11 @@ func (m *SensorStatus) MarshalTo(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 			i++
 			i = encodeVarintGenerated(dAtA, i, uint64((&v).Size()))
-			n22, err := (&v).MarshalTo(dAtA[i:])
+			n23, err := (&v).MarshalTo(dAtA[i:])
 			if err != nil {
 				return 0, err
 			}
-			i += n22
+			i += n23
 		}
 	}
 	dAtA[i] = 0x30
@@ -1430,6 +1469,50 @@ func (m *SensorStatus) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TarballArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TarballArtifact) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i += copy(dAtA[i:], m.URL)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i += copy(dAtA[i:], m.Path)
+	dAtA[i] = 0x18
+	i++
+	if m.VerifyCert {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	if m.AuthHeader != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.AuthHeader.Size()))
+		n24, err := m.AuthHeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	return i, nil
+}
+
 func (m *TimeFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1479,11 +1562,11 @@ func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Resource.Size()))
-		n23, err := m.Resource.MarshalTo(dAtA[i:])
+		n25, err := m.Resource.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n25
 	}
 	dAtA[i] = 0x1a
 	i++
@@ -1493,21 +1576,21 @@ func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.RetryStrategy.Size()))
-		n24, err := m.RetryStrategy.MarshalTo(dAtA[i:])
+		n26, err := m.RetryStrategy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n26
 	}
 	if m.When != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.When.Size()))
-		n25, err := m.When.MarshalTo(dAtA[i:])
+		n27, err := m.When.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n27
 	}
 	return i, nil
 }
@@ -1625,6 +1708,10 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.Configmap.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Tarball != nil {
+		l = m.Tarball.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1940,6 +2027,24 @@ func (m *SensorStatus) Size() (n int) {
 	return n
 }
 
+func (m *TarballArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.AuthHeader != nil {
+		l = m.AuthHeader.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *TimeFilter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2034,6 +2139,7 @@ func (this *ArtifactLocation) String() string {
 		`File:` + strings.Replace(fmt.Sprintf("%v", this.File), "FileArtifact", "FileArtifact", 1) + `,`,
 		`URL:` + strings.Replace(fmt.Sprintf("%v", this.URL), "URLArtifact", "URLArtifact", 1) + `,`,
 		`Configmap:` + strings.Replace(fmt.Sprintf("%v", this.Configmap), "ConfigmapArtifact", "ConfigmapArtifact", 1) + `,`,
+		`Tarball:` + strings.Replace(fmt.Sprintf("%v", this.Tarball), "TarballArtifact", "TarballArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2258,6 +2364,19 @@ func (this *SensorStatus) String() string {
 	}, "")
 	return s
 }
+func (this *TarballArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TarballArtifact{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`VerifyCert:` + fmt.Sprintf("%v", this.VerifyCert) + `,`,
+		`AuthHeader:` + strings.Replace(fmt.Sprintf("%v", this.AuthHeader), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TimeFilter) String() string {
 	if this == nil {
 		return "nil"
@@ -2504,6 +2623,39 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tarball", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tarball == nil {
+				m.Tarball = &TarballArtifact{}
+			}
+			if err := m.Tarball.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5151,6 +5303,167 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TarballArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TarballArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TarballArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyCert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyCert = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthHeader == nil {
+				m.AuthHeader = &v11.SecretKeySelector{}
+			}
+			if err := m.AuthHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5779,126 +6092,131 @@ var (
 )
 
 func init() {
-	proto.RegisterFile("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1/generated.proto", fileDescriptor_generated_00c8a4131a6a39b4)
-}
-
-var fileDescriptor_generated_00c8a4131a6a39b4 = []byte{
-	// 1863 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
-	0x19, 0xd7, 0xf2, 0x25, 0xf2, 0xa3, 0x64, 0xc9, 0xd3, 0x14, 0x61, 0x55, 0x94, 0x34, 0xb6, 0x17,
-	0xb7, 0x48, 0x96, 0xb6, 0x94, 0x06, 0x6e, 0x8b, 0x3e, 0x44, 0xd2, 0x8e, 0x1d, 0xc9, 0xb6, 0x32,
-	0x74, 0x1c, 0x20, 0x2d, 0x50, 0xaf, 0x76, 0x47, 0xe4, 0x46, 0xcb, 0x9d, 0xc5, 0xce, 0x50, 0x09,
-	0x8b, 0x3e, 0xd2, 0xe7, 0xb1, 0xcd, 0xff, 0xd0, 0x3f, 0xa0, 0xc7, 0xde, 0x7b, 0xf2, 0xa5, 0x40,
-	0x7a, 0xcb, 0x89, 0xa8, 0x59, 0xa0, 0x97, 0xfe, 0x07, 0x39, 0x15, 0xf3, 0xd8, 0x07, 0x97, 0x56,
-	0x4d, 0x93, 0x3e, 0x89, 0xfb, 0xcd, 0x37, 0xdf, 0x6f, 0xe6, 0x7b, 0xfe, 0x46, 0x70, 0x77, 0xe0,
-	0xf1, 0xe1, 0xf8, 0xd4, 0x72, 0xe8, 0xa8, 0x6d, 0x47, 0x03, 0x1a, 0x46, 0xf4, 0x23, 0xf9, 0xe3,
-	0x4d, 0x72, 0x41, 0x02, 0xce, 0xda, 0xe1, 0xf9, 0xa0, 0x6d, 0x87, 0x1e, 0x6b, 0x33, 0x12, 0x30,
-	0x1a, 0xb5, 0x2f, 0x6e, 0xda, 0x7e, 0x38, 0xb4, 0x6f, 0xb6, 0x07, 0x24, 0x20, 0x91, 0xcd, 0x89,
-	0x6b, 0x85, 0x11, 0xe5, 0x14, 0xdd, 0x4a, 0x2d, 0x59, 0xb1, 0x25, 0xf9, 0xe3, 0x67, 0xca, 0x92,
-	0x15, 0x9e, 0x0f, 0x2c, 0x61, 0xc9, 0x52, 0x96, 0xac, 0xd8, 0xd2, 0xde, 0x8f, 0x96, 0x3e, 0x83,
-	0x43, 0x47, 0x23, 0x1a, 0xe4, 0xa1, 0xf7, 0xde, 0xcc, 0x18, 0x18, 0xd0, 0x01, 0x6d, 0x4b, 0xf1,
-	0xe9, 0xf8, 0x4c, 0x7e, 0xc9, 0x0f, 0xf9, 0x4b, 0xab, 0x9b, 0xe7, 0xb7, 0x98, 0xe5, 0x51, 0x61,
-	0xb2, 0xed, 0xd0, 0x88, 0xb4, 0x2f, 0x16, 0x6e, 0xb3, 0xf7, 0x56, 0xaa, 0x33, 0xb2, 0x9d, 0xa1,
-	0x17, 0x90, 0x68, 0x92, 0x9e, 0x63, 0x44, 0xb8, 0xfd, 0xbc, 0x5d, 0xed, 0xcb, 0x76, 0x45, 0xe3,
-	0x80, 0x7b, 0x23, 0xb2, 0xb0, 0xe1, 0xed, 0x17, 0x6d, 0x60, 0xce, 0x90, 0x8c, 0xec, 0x85, 0x7d,
-	0x07, 0x97, 0xed, 0x1b, 0x73, 0xcf, 0x6f, 0x7b, 0x01, 0x67, 0x3c, 0xca, 0x6f, 0x32, 0xff, 0x51,
-	0x84, 0xdd, 0xc3, 0x88, 0x7b, 0x67, 0xb6, 0xc3, 0x8f, 0xa9, 0x63, 0x73, 0x8f, 0x06, 0xa8, 0x0f,
-	0x05, 0x76, 0xd0, 0x30, 0xae, 0x19, 0xd7, 0xeb, 0xfb, 0xdf, 0xb7, 0x96, 0x8e, 0xa1, 0x8a, 0x84,
-	0xd5, 0x3f, 0x88, 0x0d, 0x76, 0x2a, 0xb3, 0x69, 0xab, 0xd0, 0x3f, 0xc0, 0x05, 0x76, 0x80, 0x4c,
-	0xa8, 0x78, 0x81, 0xef, 0x05, 0xa4, 0x51, 0xb8, 0x66, 0x5c, 0xaf, 0x75, 0x60, 0x36, 0x6d, 0x55,
-	0xee, 0x49, 0x09, 0xd6, 0x2b, 0xc8, 0x85, 0xd2, 0x99, 0xe7, 0x93, 0x46, 0x51, 0x42, 0xdf, 0xb1,
-	0x56, 0x4d, 0x1f, 0xeb, 0x8e, 0xe7, 0x93, 0xe4, 0x14, 0xd5, 0xd9, 0xb4, 0x55, 0x12, 0x12, 0x2c,
-	0xad, 0xa3, 0x27, 0x50, 0x1c, 0x47, 0x7e, 0xa3, 0x24, 0x41, 0x6e, 0xaf, 0x0e, 0xf2, 0x3e, 0x3e,
-	0x4e, 0x30, 0x36, 0x67, 0xd3, 0x56, 0xf1, 0x7d, 0x7c, 0x8c, 0x85, 0x69, 0xf4, 0x09, 0xd4, 0x1c,
-	0x1a, 0x9c, 0x79, 0x83, 0x91, 0x1d, 0x36, 0xca, 0x12, 0xe7, 0x68, 0x75, 0x9c, 0x6e, 0x6c, 0x2a,
-	0x41, 0xdb, 0x9e, 0x4d, 0x5b, 0xb5, 0x44, 0x8c, 0x53, 0x30, 0xf3, 0x0f, 0x06, 0x5c, 0x5d, 0xd0,
-	0x47, 0xd7, 0xa0, 0x14, 0xd8, 0x23, 0x22, 0x43, 0x5a, 0xeb, 0x6c, 0x3d, 0x9d, 0xb6, 0x36, 0x84,
-	0x4f, 0x1e, 0xd8, 0x23, 0x82, 0xe5, 0x0a, 0x6a, 0x43, 0x4d, 0xfc, 0x65, 0xa1, 0xed, 0xc4, 0x01,
-	0xba, 0xaa, 0xd5, 0x6a, 0x0f, 0xe2, 0x05, 0x9c, 0xea, 0xa0, 0x6f, 0x40, 0xf1, 0x9c, 0x4c, 0x64,
-	0xa4, 0x6a, 0x9d, 0xba, 0x56, 0x2d, 0x1e, 0x91, 0x09, 0x16, 0x72, 0xf3, 0x97, 0x00, 0x3d, 0x9b,
-	0xdb, 0x77, 0x3c, 0x9f, 0x93, 0x48, 0xe0, 0x87, 0x36, 0x1f, 0xe6, 0xf1, 0x4f, 0x6c, 0x3e, 0xc4,
-	0x72, 0x05, 0xbd, 0x01, 0x25, 0x3e, 0x09, 0x63, 0xe8, 0x46, 0xac, 0xf1, 0x68, 0x12, 0x92, 0x2f,
-	0xa7, 0xad, 0xea, 0xbb, 0xfd, 0x87, 0x0f, 0xc4, 0x6f, 0x2c, 0xb5, 0x50, 0x0b, 0xca, 0x17, 0xb6,
-	0x3f, 0x16, 0x89, 0x52, 0xbc, 0x5e, 0xeb, 0xd4, 0x66, 0xd3, 0x56, 0xf9, 0xb1, 0x10, 0x60, 0x25,
-	0x37, 0x3d, 0xd8, 0xe9, 0x91, 0x90, 0x04, 0x2e, 0x09, 0x9c, 0xc9, 0x3b, 0x11, 0x1d, 0x87, 0x4b,
-	0xf8, 0xe0, 0x2d, 0xd8, 0x72, 0xe3, 0x4d, 0x1e, 0x61, 0x8d, 0x82, 0x34, 0xbe, 0x3b, 0x9b, 0xb6,
-	0xb6, 0x7a, 0x19, 0x39, 0x9e, 0xd3, 0x32, 0xff, 0x54, 0x80, 0x9d, 0xdb, 0x22, 0x82, 0x29, 0xe0,
-	0x12, 0x58, 0x6f, 0x40, 0xd5, 0x25, 0xb6, 0x9b, 0xd4, 0x43, 0xb1, 0xb3, 0xab, 0xb5, 0xaa, 0x3d,
-	0x2d, 0xc7, 0x89, 0x06, 0xfa, 0x39, 0x6c, 0x9e, 0x49, 0x4f, 0x32, 0x5d, 0x1a, 0x0f, 0x57, 0xcf,
-	0xa6, 0xdc, 0x59, 0x55, 0x84, 0x3a, 0x3b, 0x1a, 0x7d, 0x53, 0x7d, 0x33, 0x1c, 0x03, 0x8a, 0xcc,
-	0x70, 0x68, 0x10, 0x10, 0x87, 0x13, 0x57, 0xd6, 0x4c, 0x35, 0xcd, 0x8c, 0x6e, 0xbc, 0x80, 0x53,
-	0x1d, 0xf3, 0xbf, 0x05, 0xf8, 0xea, 0x73, 0x41, 0x96, 0x70, 0xcb, 0x29, 0x94, 0x44, 0x8b, 0x93,
-	0x2e, 0xa9, 0xef, 0xf7, 0x56, 0xbf, 0xe5, 0x23, 0x6f, 0x44, 0xf4, 0xd5, 0x64, 0xf9, 0x8b, 0x6f,
-	0x2c, 0x6d, 0x23, 0x17, 0x36, 0x1d, 0x1a, 0x70, 0xf2, 0x09, 0xd7, 0xce, 0xfc, 0xc1, 0x4b, 0xb7,
-	0x38, 0x79, 0xbd, 0xae, 0x32, 0xd2, 0xa9, 0x0b, 0xb7, 0xe9, 0x0f, 0x1c, 0x9b, 0x46, 0x67, 0x50,
-	0x72, 0x6d, 0x6e, 0x37, 0x4a, 0xd7, 0x8a, 0xeb, 0xdd, 0x24, 0x2d, 0xa3, 0xd4, 0x63, 0x42, 0x86,
-	0xa5, 0x7d, 0xf3, 0x06, 0x6c, 0x65, 0x9b, 0xdd, 0x8b, 0x4b, 0xcd, 0xfc, 0xbd, 0x01, 0xbb, 0xb2,
-	0x24, 0x1e, 0x93, 0x88, 0x79, 0x34, 0x38, 0xf2, 0x02, 0x17, 0x7d, 0x13, 0xca, 0x03, 0x21, 0xd3,
-	0xfb, 0xb6, 0xf5, 0xbe, 0xb2, 0x54, 0xc4, 0x6a, 0x0d, 0x7d, 0x0b, 0x36, 0x2f, 0xd4, 0x1e, 0x5d,
-	0xa7, 0x49, 0xd6, 0x68, 0x53, 0x38, 0x5e, 0x17, 0xc7, 0x38, 0xf7, 0x02, 0x57, 0xf7, 0x87, 0xe4,
-	0x18, 0x02, 0x0b, 0xcb, 0x15, 0xf3, 0xaf, 0x25, 0x80, 0x07, 0xd4, 0x25, 0x7d, 0x6e, 0xf3, 0x31,
-	0x43, 0x7b, 0x50, 0xf0, 0x5c, 0x8d, 0x0e, 0x5a, 0xbd, 0x70, 0xaf, 0x87, 0x0b, 0x9e, 0x9b, 0xe4,
-	0x4d, 0xe1, 0xd2, 0xbc, 0xf9, 0x0e, 0xd4, 0x5d, 0x8f, 0x85, 0xbe, 0x3d, 0x11, 0x42, 0x8d, 0xfa,
-	0x15, 0xad, 0x58, 0xef, 0xa5, 0x4b, 0x38, 0xab, 0x97, 0x74, 0x9d, 0xd2, 0xf3, 0xbb, 0x8e, 0x38,
-	0x5e, 0xa6, 0xeb, 0xdc, 0x80, 0x72, 0x38, 0xb4, 0x19, 0x91, 0x1d, 0xbd, 0xd6, 0xd9, 0x8b, 0x7d,
-	0x74, 0x22, 0x84, 0x5f, 0x8a, 0x46, 0x49, 0x5d, 0x22, 0x3f, 0xb0, 0x52, 0x44, 0x4f, 0xa0, 0xc6,
-	0xb8, 0x1d, 0x71, 0xe2, 0x1e, 0xf2, 0x46, 0x45, 0x26, 0x5b, 0xdb, 0x52, 0x63, 0xda, 0xca, 0x8e,
-	0xe9, 0x34, 0xfa, 0x82, 0x45, 0x58, 0x17, 0x37, 0xad, 0xfb, 0x9e, 0x13, 0x51, 0x91, 0xb4, 0x69,
-	0xb1, 0xf5, 0x63, 0x4b, 0x38, 0x35, 0x8a, 0xce, 0xa0, 0xee, 0xd0, 0x51, 0xe8, 0x13, 0x85, 0xb1,
-	0xb9, 0x1a, 0x46, 0xe2, 0xa9, 0x6e, 0x6a, 0x0b, 0x67, 0x0d, 0x8b, 0xd0, 0x8f, 0x08, 0x63, 0xf6,
-	0x80, 0x34, 0xaa, 0xf3, 0xa1, 0xbf, 0xaf, 0xc4, 0x38, 0x5e, 0x47, 0x1f, 0x40, 0x59, 0xe6, 0x74,
-	0xa3, 0x26, 0x0f, 0xf3, 0xf6, 0x6a, 0xd5, 0xa5, 0x9a, 0xba, 0xfc, 0x89, 0x95, 0x3d, 0xf3, 0xb7,
-	0x65, 0xb8, 0x82, 0x09, 0xa3, 0xe3, 0xc8, 0x21, 0x0f, 0x4f, 0x3f, 0x22, 0x0e, 0x9f, 0x1f, 0x5b,
-	0xc6, 0x12, 0x63, 0xeb, 0x17, 0x50, 0xf1, 0xed, 0x53, 0xe2, 0x33, 0x39, 0x3a, 0xea, 0xfb, 0x8f,
-	0x56, 0x2f, 0xcc, 0xf9, 0xa3, 0x58, 0xc7, 0xd2, 0xec, 0xed, 0x80, 0x47, 0x93, 0xce, 0x15, 0x7d,
-	0x86, 0x8a, 0x12, 0x62, 0x8d, 0x89, 0x7e, 0x0d, 0x10, 0xda, 0x91, 0x3d, 0x22, 0xb2, 0x95, 0xab,
-	0xd6, 0x70, 0xb4, 0xfe, 0x09, 0x4e, 0x62, 0x9b, 0x1d, 0xa4, 0x81, 0x21, 0x11, 0x31, 0x9c, 0x81,
-	0x44, 0x9f, 0x19, 0xb0, 0x3b, 0xc8, 0xd5, 0xbe, 0x26, 0x28, 0xef, 0xae, 0x7e, 0x8e, 0x7c, 0x37,
-	0x49, 0x2a, 0x69, 0xa1, 0xcf, 0xe0, 0x05, 0x74, 0x14, 0x41, 0x45, 0xdd, 0x42, 0x17, 0xc8, 0x1a,
-	0xe7, 0xc8, 0x13, 0xd9, 0x34, 0x0e, 0x7d, 0x89, 0x80, 0x35, 0xd2, 0xde, 0x77, 0xa1, 0x9e, 0x09,
-	0x17, 0xda, 0x55, 0x5c, 0x46, 0xe6, 0x8f, 0xa4, 0x2f, 0xe8, 0xb5, 0x98, 0x60, 0xc8, 0x96, 0xa3,
-	0x59, 0xc5, 0xf7, 0x0a, 0xb7, 0x0c, 0xf3, 0x2f, 0x06, 0x5c, 0x5d, 0xf0, 0x3b, 0xf2, 0xa1, 0xc8,
-	0x22, 0x47, 0x53, 0xe6, 0xf7, 0x5e, 0x61, 0x44, 0xd5, 0xc1, 0x15, 0xbd, 0xec, 0x47, 0x0e, 0x16,
-	0x30, 0xa2, 0x1f, 0xba, 0x84, 0xf1, 0x7c, 0x3f, 0xec, 0x11, 0xc6, 0xb1, 0x5c, 0x31, 0x7f, 0x63,
-	0xc0, 0xeb, 0x97, 0xd8, 0x12, 0xad, 0x5e, 0xd5, 0x67, 0xae, 0xd5, 0x67, 0x6b, 0x2d, 0x19, 0x23,
-	0x85, 0x4b, 0x19, 0x5b, 0x86, 0x83, 0x19, 0xcf, 0xe5, 0x60, 0x3b, 0xb0, 0x8d, 0x09, 0x8f, 0x26,
-	0x7d, 0x2e, 0x1e, 0x1c, 0x83, 0x89, 0xf9, 0xf7, 0x02, 0x54, 0xfa, 0xf2, 0xc2, 0xe8, 0x09, 0x54,
-	0x45, 0x17, 0x92, 0x13, 0x52, 0x39, 0xed, 0xc6, 0x72, 0x3d, 0x4b, 0x15, 0xdb, 0x7d, 0xc2, 0xed,
-	0x34, 0xd7, 0x53, 0x19, 0x4e, 0xac, 0x8a, 0xf9, 0xcb, 0x42, 0xe2, 0xac, 0xcf, 0x24, 0xd4, 0x89,
-	0xfb, 0x21, 0x71, 0x52, 0x37, 0x88, 0x2f, 0x2c, 0xed, 0xa3, 0x00, 0x2a, 0x4c, 0x4e, 0xb0, 0xf5,
-	0x1f, 0x2d, 0x1a, 0x49, 0x5a, 0xcb, 0xa4, 0xae, 0xfc, 0xc6, 0x1a, 0xc5, 0xfc, 0xa7, 0x01, 0xa0,
-	0x14, 0x8f, 0x3d, 0xc6, 0xd1, 0x4f, 0x17, 0x1c, 0x69, 0x2d, 0xe7, 0x48, 0xb1, 0x5b, 0xba, 0x31,
-	0xe1, 0x9d, 0xb1, 0x24, 0xe3, 0x44, 0x02, 0x65, 0x8f, 0x93, 0x91, 0xa2, 0xc2, 0xf5, 0xfd, 0x1f,
-	0xaf, 0x7b, 0xb7, 0x34, 0xd9, 0xee, 0x09, 0xb3, 0x58, 0x59, 0x37, 0xff, 0x58, 0x8e, 0xef, 0x24,
-	0x1c, 0x8b, 0x7e, 0x67, 0xe4, 0x88, 0xb8, 0x21, 0xd1, 0xef, 0xbd, 0x32, 0xce, 0xdb, 0x79, 0x4d,
-	0x1f, 0xe3, 0xff, 0xf0, 0x7a, 0x44, 0xa1, 0xca, 0x23, 0x6f, 0x30, 0x10, 0x9d, 0x5a, 0x5d, 0xff,
-	0x70, 0x0d, 0x3a, 0xaa, 0x2c, 0xa5, 0xce, 0xd6, 0x02, 0x86, 0x13, 0x10, 0x74, 0x04, 0xe0, 0x92,
-	0xd0, 0xa7, 0x13, 0xe1, 0x04, 0x9d, 0x4d, 0x5f, 0xcf, 0x04, 0xd3, 0x72, 0x68, 0x44, 0x44, 0xe8,
-	0x4e, 0xa8, 0x2b, 0xd3, 0xf1, 0x8a, 0x48, 0xfe, 0x5e, 0xb2, 0x05, 0x67, 0xb6, 0xa3, 0x8f, 0x61,
-	0x5b, 0x9e, 0xe9, 0x44, 0xbc, 0xf2, 0x1d, 0x1a, 0xbf, 0x76, 0x7f, 0xb8, 0xda, 0x30, 0x8e, 0xad,
-	0x74, 0xae, 0xce, 0xa6, 0xad, 0xed, 0x39, 0x11, 0x9e, 0xc7, 0x11, 0x44, 0xc1, 0xf1, 0x22, 0x67,
-	0xec, 0x71, 0x4d, 0x93, 0x12, 0xa2, 0xd0, 0x55, 0x62, 0x1c, 0xaf, 0xa3, 0x3f, 0x1b, 0xb0, 0xeb,
-	0xce, 0xbf, 0xd2, 0x58, 0xa3, 0xb2, 0x6e, 0xac, 0x73, 0xef, 0xbe, 0x74, 0x16, 0xe5, 0x16, 0x18,
-	0x5e, 0x00, 0x37, 0xff, 0x53, 0x82, 0xad, 0x6c, 0x15, 0xa6, 0x94, 0xcf, 0x58, 0x96, 0xf2, 0xfd,
-	0x24, 0x4b, 0xf9, 0x54, 0xf3, 0xf9, 0xf6, 0x72, 0x15, 0xb9, 0x04, 0xdb, 0xb3, 0xe7, 0xd9, 0x5e,
-	0xf1, 0xa5, 0xcd, 0xbf, 0x14, 0xd1, 0x2b, 0xbd, 0x80, 0xe8, 0x5d, 0x40, 0x39, 0xa0, 0x2e, 0x61,
-	0x8d, 0xb2, 0x8c, 0xd9, 0x7b, 0xaf, 0xa6, 0xf3, 0x59, 0xc2, 0xa5, 0x9a, 0x47, 0x25, 0xed, 0x42,
-	0xca, 0xb0, 0x82, 0x43, 0x87, 0xb0, 0xa3, 0x4f, 0xec, 0xd1, 0xa0, 0x4b, 0xc7, 0x81, 0xe2, 0xd6,
-	0xe5, 0xce, 0xeb, 0x5a, 0x7d, 0xa7, 0x3b, 0xbf, 0x8c, 0xf3, 0xfa, 0x7b, 0xbf, 0x52, 0x6f, 0x8f,
-	0x4b, 0xe7, 0xff, 0x87, 0xd9, 0xf9, 0xbf, 0xd6, 0xf8, 0x48, 0x9f, 0x38, 0x59, 0x16, 0xd1, 0x07,
-	0x48, 0x5f, 0xa8, 0x62, 0x22, 0xcb, 0x18, 0xe7, 0x27, 0xb2, 0xcc, 0x01, 0xac, 0xd6, 0xc4, 0x44,
-	0x66, 0x9c, 0x86, 0xf9, 0x89, 0xdc, 0xe7, 0x34, 0xc4, 0x72, 0xc5, 0xfc, 0x5b, 0x11, 0x36, 0x75,
-	0x5f, 0x59, 0xe2, 0xa9, 0x1d, 0x41, 0x35, 0xd2, 0x0c, 0x41, 0xdf, 0xf2, 0xee, 0xab, 0xe2, 0xc2,
-	0x9d, 0x2d, 0xd1, 0xe2, 0x62, 0x19, 0x4e, 0x70, 0xb2, 0xc9, 0x55, 0x7c, 0x41, 0x72, 0x7d, 0x6a,
-	0xc0, 0x76, 0x44, 0x42, 0x3f, 0xa1, 0x0f, 0xba, 0x83, 0xbd, 0xb3, 0xce, 0x21, 0x33, 0x6c, 0x44,
-	0xb5, 0xb2, 0x39, 0x11, 0x9e, 0x07, 0x44, 0x43, 0x28, 0x7d, 0x3c, 0x24, 0xc1, 0xfa, 0xfc, 0x58,
-	0x07, 0xa5, 0x4b, 0x03, 0xd7, 0x93, 0xbc, 0x54, 0xfe, 0x4b, 0xe2, 0x83, 0x21, 0x09, 0xb0, 0x44,
-	0x30, 0xef, 0xc2, 0x6e, 0x5e, 0x07, 0x7d, 0x0d, 0x8a, 0x76, 0x30, 0x91, 0xb3, 0xaf, 0xa6, 0xf8,
-	0xdf, 0x61, 0x30, 0xc1, 0x42, 0x26, 0x97, 0x7c, 0x5f, 0xff, 0x7f, 0x4a, 0x2d, 0xf9, 0x3e, 0x16,
-	0x32, 0xd3, 0x81, 0x7a, 0xe6, 0xdf, 0x92, 0x4b, 0xfc, 0xe3, 0x6d, 0x1f, 0xe0, 0x82, 0x44, 0xde,
-	0xd9, 0xa4, 0x4b, 0x22, 0xd5, 0xb0, 0xaa, 0x29, 0xb3, 0x7a, 0x9c, 0xac, 0xe0, 0x8c, 0x56, 0xc7,
-	0x7a, 0xfa, 0xac, 0xb9, 0xf1, 0xf9, 0xb3, 0xe6, 0xc6, 0x17, 0xcf, 0x9a, 0x1b, 0x9f, 0xce, 0x9a,
-	0xc6, 0xd3, 0x59, 0xd3, 0xf8, 0x7c, 0xd6, 0x34, 0xbe, 0x98, 0x35, 0x8d, 0x7f, 0xcd, 0x9a, 0xc6,
-	0x67, 0xff, 0x6e, 0x6e, 0x7c, 0x58, 0x8d, 0xef, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x12,
-	0x2d, 0xde, 0x4c, 0x51, 0x18, 0x00, 0x00,
+	proto.RegisterFile("github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1/generated.proto", fileDescriptor_generated_5e8d88b6ac345da4)
+}
+
+var fileDescriptor_generated_5e8d88b6ac345da4 = []byte{
+	// 1951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0xf0, 0x25, 0xb2, 0x28, 0x59, 0x72, 0x67, 0x83, 0x65, 0x14, 0xac, 0x28, 0x4c, 0x10,
+	0xc0, 0x09, 0x76, 0x87, 0xb6, 0xb4, 0x59, 0x38, 0x09, 0xf2, 0x10, 0x25, 0x7b, 0xed, 0x95, 0x6c,
+	0x2b, 0x4d, 0xdb, 0x0b, 0x6c, 0x02, 0xc4, 0xad, 0x99, 0x16, 0x39, 0xab, 0xe1, 0xf4, 0xa0, 0xa7,
+	0xa9, 0x5d, 0x06, 0x79, 0x6c, 0x9e, 0xc7, 0x64, 0xff, 0x43, 0x7e, 0x40, 0x8e, 0x39, 0x06, 0xc8,
+	0xc9, 0xc7, 0xcd, 0x6d, 0x2f, 0x21, 0x62, 0x06, 0xc8, 0x25, 0xff, 0x60, 0x4f, 0x41, 0x3f, 0xe6,
+	0x41, 0x4a, 0x8a, 0x68, 0xd2, 0x27, 0x72, 0xaa, 0xaa, 0xeb, 0xab, 0xae, 0xae, 0xaa, 0xfe, 0x66,
+	0xe0, 0x5e, 0xd7, 0x17, 0xbd, 0xc1, 0xb1, 0xe3, 0xb2, 0x7e, 0x8b, 0xf0, 0x2e, 0x8b, 0x38, 0xfb,
+	0x50, 0xfd, 0x79, 0x8b, 0x9e, 0xd1, 0x50, 0xc4, 0xad, 0xe8, 0xb4, 0xdb, 0x22, 0x91, 0x1f, 0xb7,
+	0x62, 0x1a, 0xc6, 0x8c, 0xb7, 0xce, 0x6e, 0x91, 0x20, 0xea, 0x91, 0x5b, 0xad, 0x2e, 0x0d, 0x29,
+	0x27, 0x82, 0x7a, 0x4e, 0xc4, 0x99, 0x60, 0xe8, 0x76, 0xe6, 0xc9, 0x49, 0x3c, 0xa9, 0x3f, 0x3f,
+	0xd5, 0x9e, 0x9c, 0xe8, 0xb4, 0xeb, 0x48, 0x4f, 0x8e, 0xf6, 0xe4, 0x24, 0x9e, 0x36, 0x7e, 0x30,
+	0x73, 0x0c, 0x2e, 0xeb, 0xf7, 0x59, 0x38, 0x0d, 0xbd, 0xf1, 0x56, 0xce, 0x41, 0x97, 0x75, 0x59,
+	0x4b, 0x89, 0x8f, 0x07, 0x27, 0xea, 0x49, 0x3d, 0xa8, 0x7f, 0xc6, 0xdc, 0x3e, 0xbd, 0x1d, 0x3b,
+	0x3e, 0x93, 0x2e, 0x5b, 0x2e, 0xe3, 0xb4, 0x75, 0x76, 0x6e, 0x37, 0x1b, 0x6f, 0x67, 0x36, 0x7d,
+	0xe2, 0xf6, 0xfc, 0x90, 0xf2, 0x61, 0x16, 0x47, 0x9f, 0x0a, 0x72, 0xd1, 0xaa, 0xd6, 0x65, 0xab,
+	0xf8, 0x20, 0x14, 0x7e, 0x9f, 0x9e, 0x5b, 0xf0, 0xce, 0x55, 0x0b, 0x62, 0xb7, 0x47, 0xfb, 0xe4,
+	0xdc, 0xba, 0x9d, 0xcb, 0xd6, 0x0d, 0x84, 0x1f, 0xb4, 0xfc, 0x50, 0xc4, 0x82, 0x4f, 0x2f, 0xb2,
+	0xff, 0x56, 0x82, 0xf5, 0x5d, 0x2e, 0xfc, 0x13, 0xe2, 0x8a, 0x43, 0xe6, 0x12, 0xe1, 0xb3, 0x10,
+	0x75, 0xa0, 0x10, 0xef, 0x34, 0xac, 0x2d, 0xeb, 0x46, 0x7d, 0xfb, 0xbb, 0xce, 0xcc, 0x67, 0xa8,
+	0x4f, 0xc2, 0xe9, 0xec, 0x24, 0x0e, 0xdb, 0x95, 0xf1, 0xa8, 0x59, 0xe8, 0xec, 0xe0, 0x42, 0xbc,
+	0x83, 0x6c, 0xa8, 0xf8, 0x61, 0xe0, 0x87, 0xb4, 0x51, 0xd8, 0xb2, 0x6e, 0xd4, 0xda, 0x30, 0x1e,
+	0x35, 0x2b, 0xf7, 0x95, 0x04, 0x1b, 0x0d, 0xf2, 0xa0, 0x74, 0xe2, 0x07, 0xb4, 0x51, 0x54, 0xd0,
+	0x77, 0x9d, 0x79, 0xcb, 0xc7, 0xb9, 0xeb, 0x07, 0x34, 0x8d, 0xa2, 0x3a, 0x1e, 0x35, 0x4b, 0x52,
+	0x82, 0x95, 0x77, 0xf4, 0x0c, 0x8a, 0x03, 0x1e, 0x34, 0x4a, 0x0a, 0xe4, 0xce, 0xfc, 0x20, 0x4f,
+	0xf0, 0x61, 0x8a, 0xb1, 0x3c, 0x1e, 0x35, 0x8b, 0x4f, 0xf0, 0x21, 0x96, 0xae, 0xd1, 0xc7, 0x50,
+	0x73, 0x59, 0x78, 0xe2, 0x77, 0xfb, 0x24, 0x6a, 0x94, 0x15, 0xce, 0xc1, 0xfc, 0x38, 0x7b, 0x89,
+	0xab, 0x14, 0x6d, 0x75, 0x3c, 0x6a, 0xd6, 0x52, 0x31, 0xce, 0xc0, 0x50, 0x04, 0xcb, 0x82, 0xf0,
+	0x63, 0x12, 0x04, 0x8d, 0x8a, 0xc2, 0xbd, 0x3f, 0x3f, 0xee, 0x63, 0xed, 0x28, 0x45, 0xad, 0x8f,
+	0x47, 0xcd, 0x65, 0x23, 0xc4, 0x09, 0x8c, 0xfd, 0x7b, 0x0b, 0xae, 0x9f, 0x8b, 0x10, 0x6d, 0x41,
+	0x29, 0x24, 0x7d, 0xaa, 0x8a, 0xa8, 0xd6, 0x5e, 0x79, 0x3e, 0x6a, 0x2e, 0xc9, 0x53, 0x78, 0x48,
+	0xfa, 0x14, 0x2b, 0x0d, 0x6a, 0x41, 0x4d, 0xfe, 0xc6, 0x11, 0x71, 0x93, 0x92, 0xb8, 0x6e, 0xcc,
+	0x6a, 0x0f, 0x13, 0x05, 0xce, 0x6c, 0xd0, 0x1b, 0x50, 0x3c, 0xa5, 0x43, 0x55, 0x1b, 0xb5, 0x76,
+	0xdd, 0x98, 0x16, 0x0f, 0xe8, 0x10, 0x4b, 0xb9, 0xfd, 0x0b, 0x80, 0x7d, 0x22, 0xc8, 0x5d, 0x3f,
+	0x10, 0x94, 0x4b, 0xfc, 0x88, 0x88, 0xde, 0x34, 0xfe, 0x11, 0x11, 0x3d, 0xac, 0x34, 0xe8, 0x4d,
+	0x28, 0x89, 0x61, 0x94, 0x40, 0x37, 0x12, 0x8b, 0xc7, 0xc3, 0x88, 0x7e, 0x31, 0x6a, 0x56, 0xdf,
+	0xeb, 0x3c, 0x7a, 0x28, 0xff, 0x63, 0x65, 0x85, 0x9a, 0x50, 0x3e, 0x23, 0xc1, 0x40, 0x96, 0x66,
+	0xf1, 0x46, 0xad, 0x5d, 0x1b, 0x8f, 0x9a, 0xe5, 0xa7, 0x52, 0x80, 0xb5, 0xdc, 0xf6, 0x61, 0x6d,
+	0x9f, 0x46, 0x34, 0xf4, 0x68, 0xe8, 0x0e, 0xdf, 0xe5, 0x6c, 0x10, 0xcd, 0x90, 0x83, 0xb7, 0x61,
+	0xc5, 0x4b, 0x16, 0xf9, 0x34, 0x6e, 0x14, 0x94, 0xf3, 0xf5, 0xf1, 0xa8, 0xb9, 0xb2, 0x9f, 0x93,
+	0xe3, 0x09, 0x2b, 0xfb, 0x8f, 0x05, 0x58, 0xbb, 0x23, 0xcf, 0x2e, 0x03, 0x9c, 0x01, 0xeb, 0x4d,
+	0xa8, 0x7a, 0x94, 0x78, 0x69, 0x07, 0x16, 0xdb, 0xeb, 0xc6, 0xaa, 0xba, 0x6f, 0xe4, 0x38, 0xb5,
+	0x40, 0x3f, 0x83, 0xe5, 0x13, 0x95, 0xc9, 0xd8, 0x34, 0xe3, 0xa3, 0xf9, 0xeb, 0x68, 0x2a, 0x56,
+	0x7d, 0x42, 0xed, 0x35, 0x83, 0xbe, 0xac, 0x9f, 0x63, 0x9c, 0x00, 0xca, 0xca, 0x70, 0x59, 0x18,
+	0x52, 0x57, 0x50, 0x4f, 0x75, 0x69, 0x35, 0xab, 0x8c, 0xbd, 0x44, 0x81, 0x33, 0x1b, 0xfb, 0xbf,
+	0x05, 0xf8, 0xf2, 0x85, 0x20, 0x33, 0xa4, 0xe5, 0x18, 0x4a, 0x72, 0xa8, 0xaa, 0x94, 0xd4, 0xb7,
+	0xf7, 0x17, 0xe8, 0x16, 0xbf, 0x4f, 0xcd, 0xd6, 0xd4, 0xc0, 0x91, 0xcf, 0x58, 0xf9, 0x46, 0x1e,
+	0x2c, 0xbb, 0x2c, 0x14, 0xf4, 0x63, 0x61, 0x92, 0xf9, 0xbd, 0x97, 0x1e, 0xaa, 0x6a, 0x7b, 0x7b,
+	0xda, 0x89, 0x6e, 0x44, 0xf3, 0x80, 0x13, 0xd7, 0xe8, 0x04, 0x4a, 0x1e, 0x11, 0xa4, 0x51, 0xda,
+	0x2a, 0x2e, 0xb6, 0x93, 0xac, 0x8d, 0xb2, 0x8c, 0x49, 0x19, 0x56, 0xfe, 0xed, 0x9b, 0xb0, 0x92,
+	0x1f, 0xaf, 0x57, 0xb7, 0x9a, 0xfd, 0x3b, 0x0b, 0xd6, 0x55, 0x4b, 0x3c, 0xa5, 0x3c, 0xf6, 0x59,
+	0x78, 0xe0, 0x87, 0x1e, 0xfa, 0x1a, 0x94, 0xbb, 0x52, 0x66, 0xd6, 0xad, 0x9a, 0x75, 0x65, 0x65,
+	0x88, 0xb5, 0x0e, 0x7d, 0x03, 0x96, 0xcf, 0xf4, 0x1a, 0xd3, 0xa7, 0x69, 0xd5, 0x18, 0x57, 0x38,
+	0xd1, 0xcb, 0x30, 0x4e, 0xfd, 0xd0, 0x6b, 0x14, 0x27, 0xc3, 0x90, 0x58, 0x58, 0x69, 0xec, 0xbf,
+	0x94, 0x00, 0x1e, 0x32, 0x8f, 0x76, 0x04, 0x11, 0x83, 0x18, 0x6d, 0x40, 0xc1, 0xf7, 0x0c, 0x3a,
+	0x18, 0xf3, 0xc2, 0xfd, 0x7d, 0x5c, 0xf0, 0xbd, 0xb4, 0x6e, 0x0a, 0x97, 0xd6, 0xcd, 0xb7, 0xa0,
+	0xee, 0xf9, 0x71, 0x14, 0x90, 0xa1, 0x14, 0x1a, 0xd4, 0x2f, 0x19, 0xc3, 0xfa, 0x7e, 0xa6, 0xc2,
+	0x79, 0xbb, 0x74, 0xea, 0x94, 0x2e, 0x9e, 0x3a, 0x32, 0xbc, 0xdc, 0xd4, 0xb9, 0x09, 0xe5, 0xa8,
+	0x47, 0x62, 0xaa, 0xee, 0x90, 0x5a, 0x7b, 0x23, 0xc9, 0xd1, 0x91, 0x14, 0x7e, 0x21, 0x07, 0x25,
+	0xf3, 0xa8, 0x7a, 0xc0, 0xda, 0x10, 0x3d, 0x83, 0x5a, 0x2c, 0x08, 0x17, 0xd4, 0xdb, 0x15, 0xe6,
+	0x06, 0x68, 0x39, 0x9a, 0x18, 0x38, 0x79, 0x62, 0x90, 0x9d, 0xbe, 0xe4, 0x2d, 0xce, 0xd9, 0x2d,
+	0xe7, 0x81, 0xef, 0x72, 0x26, 0x8b, 0x36, 0x6b, 0xb6, 0x4e, 0xe2, 0x09, 0x67, 0x4e, 0xd1, 0x09,
+	0xd4, 0x5d, 0xd6, 0x8f, 0x02, 0xaa, 0x31, 0x96, 0xe7, 0xc3, 0x48, 0x33, 0xb5, 0x97, 0xf9, 0xc2,
+	0x79, 0xc7, 0xf2, 0xe8, 0xfb, 0x34, 0x8e, 0x49, 0x97, 0x36, 0xaa, 0x93, 0x47, 0xff, 0x40, 0x8b,
+	0x71, 0xa2, 0x47, 0xef, 0x43, 0x59, 0xd5, 0x74, 0xa3, 0xa6, 0x82, 0x79, 0x67, 0xbe, 0xee, 0xd2,
+	0x43, 0x5d, 0xfd, 0xc5, 0xda, 0x9f, 0xfd, 0x9b, 0x32, 0x5c, 0xc3, 0x34, 0x66, 0x03, 0xee, 0xd2,
+	0x47, 0xc7, 0x1f, 0x52, 0x57, 0x4c, 0x5e, 0x5b, 0xd6, 0x0c, 0xd7, 0xd6, 0xcf, 0xa1, 0x12, 0x90,
+	0x63, 0x1a, 0xc4, 0xea, 0xea, 0xa8, 0x6f, 0x3f, 0x9e, 0xbf, 0x31, 0x27, 0x43, 0x71, 0x0e, 0x95,
+	0xdb, 0x3b, 0xa1, 0xe0, 0xc3, 0xf6, 0x35, 0x13, 0x43, 0x45, 0x0b, 0xb1, 0xc1, 0x44, 0xbf, 0x02,
+	0x88, 0x08, 0x27, 0x7d, 0xaa, 0x46, 0xb9, 0x1e, 0x0d, 0x07, 0x8b, 0x47, 0x70, 0x94, 0xf8, 0x6c,
+	0x23, 0x03, 0x0c, 0xa9, 0x28, 0xc6, 0x39, 0x48, 0xf4, 0xa9, 0x05, 0xeb, 0xdd, 0xa9, 0xde, 0x37,
+	0x94, 0xe8, 0xbd, 0xf9, 0xe3, 0x98, 0x9e, 0x26, 0x69, 0x27, 0x9d, 0x9b, 0x33, 0xf8, 0x1c, 0x3a,
+	0xe2, 0x50, 0xd1, 0xbb, 0x68, 0x54, 0x16, 0x8d, 0x63, 0x9a, 0x3a, 0x67, 0xe7, 0xd0, 0x51, 0x08,
+	0xd8, 0x20, 0x6d, 0x7c, 0x1b, 0xea, 0xb9, 0xe3, 0x42, 0xeb, 0x9a, 0xcb, 0xa8, 0xfa, 0x51, 0xf4,
+	0x05, 0xbd, 0x96, 0x10, 0x0c, 0x35, 0x72, 0x0c, 0xab, 0xf8, 0x4e, 0xe1, 0xb6, 0x65, 0xff, 0xd9,
+	0x82, 0xeb, 0xe7, 0xf2, 0x8e, 0x02, 0x28, 0xc6, 0xdc, 0x35, 0x24, 0xfd, 0x47, 0xaf, 0xf0, 0x44,
+	0x75, 0xe0, 0x9a, 0xd0, 0x76, 0xb8, 0x8b, 0x25, 0x8c, 0x9c, 0x87, 0x1e, 0x8d, 0xc5, 0xf4, 0x3c,
+	0xdc, 0xa7, 0xb1, 0xc0, 0x4a, 0x63, 0xff, 0xda, 0x82, 0xd7, 0x2f, 0xf1, 0x25, 0x47, 0xbd, 0xee,
+	0xcf, 0xa9, 0x51, 0x9f, 0xef, 0xb5, 0xf4, 0x1a, 0x29, 0x5c, 0xca, 0xd8, 0x72, 0x1c, 0xcc, 0xba,
+	0x90, 0x83, 0xad, 0xc1, 0x2a, 0xa6, 0x82, 0x0f, 0x3b, 0x82, 0x13, 0x41, 0xbb, 0x43, 0xfb, 0xef,
+	0x05, 0xa8, 0x74, 0xd4, 0x86, 0xd1, 0x33, 0xa8, 0xca, 0x29, 0xa4, 0x6e, 0x48, 0x9d, 0xb4, 0x9b,
+	0xb3, 0xcd, 0x2c, 0xdd, 0x6c, 0x0f, 0xa8, 0x20, 0x59, 0xad, 0x67, 0x32, 0x9c, 0x7a, 0x95, 0xf7,
+	0x6f, 0x1c, 0x51, 0x77, 0x71, 0x26, 0xa1, 0x23, 0xee, 0x44, 0xd4, 0xcd, 0xd2, 0x20, 0x9f, 0xb0,
+	0xf2, 0x8f, 0x42, 0xa8, 0xc4, 0xea, 0x06, 0x5b, 0xfc, 0x35, 0xc9, 0x20, 0x29, 0x6f, 0xb9, 0xd2,
+	0x55, 0xcf, 0xd8, 0xa0, 0xd8, 0xff, 0xb0, 0x00, 0xb4, 0xe1, 0xa1, 0x1f, 0x0b, 0xf4, 0x93, 0x73,
+	0x89, 0x74, 0x66, 0x4b, 0xa4, 0x5c, 0xad, 0xd2, 0x98, 0xf2, 0xce, 0x44, 0x92, 0x4b, 0x22, 0x85,
+	0xb2, 0x2f, 0x68, 0x5f, 0x53, 0xe1, 0xfa, 0xf6, 0x0f, 0x17, 0xdd, 0x5b, 0x56, 0x6c, 0xf7, 0xa5,
+	0x5b, 0xac, 0xbd, 0xdb, 0x7f, 0x28, 0x27, 0x7b, 0x92, 0x89, 0x45, 0xbf, 0xb5, 0xa6, 0x88, 0xb8,
+	0xb5, 0x55, 0x5c, 0xec, 0xdd, 0x69, 0x8a, 0x8e, 0xb6, 0x5f, 0x33, 0x61, 0xfc, 0x1f, 0x5e, 0x8f,
+	0x18, 0x54, 0x05, 0xf7, 0xbb, 0x5d, 0xca, 0x93, 0xed, 0xef, 0x2e, 0x40, 0x47, 0xb5, 0xa7, 0x2c,
+	0xd9, 0x46, 0x10, 0xe3, 0x14, 0x04, 0x1d, 0x00, 0x78, 0x34, 0x0a, 0xd8, 0x50, 0x26, 0xc1, 0x54,
+	0xd3, 0x57, 0x73, 0x87, 0xe9, 0xc8, 0x2f, 0x21, 0xf2, 0xe8, 0x8e, 0x98, 0xa7, 0xca, 0xf1, 0x9a,
+	0x2c, 0xfe, 0xfd, 0x74, 0x09, 0xce, 0x2d, 0x47, 0x1f, 0xc1, 0xaa, 0x8a, 0xe9, 0x88, 0x33, 0xc1,
+	0x5c, 0x96, 0xbc, 0x5f, 0x7f, 0x7f, 0xbe, 0xcb, 0x38, 0xf1, 0xd2, 0xbe, 0x3e, 0x1e, 0x35, 0x57,
+	0x27, 0x44, 0x78, 0x12, 0x47, 0x12, 0x05, 0xd7, 0xe7, 0xee, 0xc0, 0x17, 0x8d, 0xf2, 0x24, 0x51,
+	0xd8, 0xd3, 0x62, 0x9c, 0xe8, 0xd1, 0x9f, 0x2c, 0x58, 0xf7, 0x26, 0xdf, 0xd2, 0xe2, 0x46, 0x65,
+	0xd1, 0xb3, 0x9e, 0x7a, 0xef, 0xcb, 0xee, 0xa2, 0x29, 0x45, 0x8c, 0xcf, 0x81, 0xdb, 0xff, 0x29,
+	0xc1, 0x4a, 0xbe, 0x0b, 0x33, 0xca, 0x67, 0xcd, 0x4a, 0xf9, 0x7e, 0x9c, 0xa7, 0x7c, 0x7a, 0xf8,
+	0x7c, 0x73, 0xb6, 0x8e, 0x9c, 0x81, 0xed, 0x91, 0x49, 0xb6, 0x57, 0x7c, 0x69, 0xf7, 0x2f, 0x45,
+	0xf4, 0x4a, 0x57, 0x10, 0xbd, 0x33, 0x28, 0x87, 0xcc, 0xa3, 0x71, 0xa3, 0xbc, 0x55, 0x5c, 0xec,
+	0xda, 0xcb, 0xe7, 0xdc, 0x91, 0x29, 0x35, 0x3c, 0x2a, 0x1d, 0x17, 0x4a, 0x86, 0x35, 0x1c, 0xda,
+	0x85, 0x35, 0x13, 0xb1, 0xcf, 0xc2, 0x3d, 0x36, 0x08, 0x35, 0xb7, 0x2e, 0xb7, 0x5f, 0x37, 0xe6,
+	0x6b, 0x7b, 0x93, 0x6a, 0x3c, 0x6d, 0xbf, 0xf1, 0x4b, 0x80, 0x0c, 0xe6, 0x82, 0xfb, 0xff, 0x83,
+	0xfc, 0xfd, 0xbf, 0xd0, 0xf5, 0x91, 0xbd, 0xe2, 0xe4, 0x59, 0xc4, 0x3f, 0x2d, 0x58, 0x9b, 0xfa,
+	0xa0, 0x83, 0xde, 0xd0, 0x1f, 0xc2, 0xac, 0xc9, 0x2f, 0x2a, 0xe9, 0x57, 0xac, 0xab, 0x6f, 0xe4,
+	0x6d, 0x80, 0x33, 0xca, 0xfd, 0x93, 0xe1, 0x1e, 0xe5, 0xba, 0x38, 0xaa, 0xd9, 0x25, 0xf9, 0x34,
+	0xd5, 0xe0, 0x9c, 0x15, 0x7a, 0x02, 0x40, 0x06, 0xa2, 0x77, 0x8f, 0x12, 0x8f, 0x72, 0x33, 0x24,
+	0xbe, 0x7e, 0xd1, 0xd0, 0xe9, 0x50, 0x97, 0x53, 0x71, 0x40, 0x87, 0x1d, 0x1a, 0x50, 0x57, 0x30,
+	0xae, 0xc7, 0xcf, 0x6e, 0xba, 0x18, 0xe7, 0x1c, 0xd9, 0x1d, 0x80, 0xec, 0x0d, 0x5c, 0x32, 0x0e,
+	0x55, 0xc3, 0xd3, 0x8c, 0x43, 0xd5, 0x38, 0xd6, 0x3a, 0xb9, 0xbf, 0x58, 0xb0, 0x68, 0x7a, 0x7f,
+	0x1d, 0xc1, 0x22, 0xac, 0x34, 0xf6, 0x5f, 0x8b, 0xb0, 0x6c, 0xe6, 0xe6, 0x0c, 0x9f, 0x12, 0x38,
+	0x54, 0xb9, 0x61, 0x40, 0xe6, 0x14, 0xef, 0xbd, 0x2a, 0xae, 0xdf, 0x5e, 0x91, 0x23, 0x3c, 0x91,
+	0xe1, 0x14, 0x27, 0xdf, 0x3c, 0xc5, 0x2b, 0x9a, 0xe7, 0x13, 0x0b, 0x56, 0x39, 0x8d, 0x82, 0x94,
+	0x1e, 0x99, 0xe4, 0xbf, 0xbb, 0x48, 0x90, 0x39, 0xb6, 0xa5, 0x47, 0xf5, 0x84, 0x08, 0x4f, 0x02,
+	0xa2, 0x1e, 0x94, 0x3e, 0xea, 0xd1, 0x70, 0x71, 0xfe, 0x6f, 0x0e, 0x65, 0x8f, 0x85, 0x9e, 0xaf,
+	0x78, 0xb7, 0xfa, 0xe4, 0xf2, 0x7e, 0x8f, 0x86, 0x58, 0x21, 0xd8, 0xf7, 0x60, 0x7d, 0xda, 0x06,
+	0x7d, 0x05, 0x8a, 0x24, 0x1c, 0xaa, 0xbb, 0xbd, 0xa6, 0xf9, 0xed, 0x6e, 0x38, 0xc4, 0x52, 0xa6,
+	0x54, 0x41, 0xd0, 0x28, 0xe4, 0x54, 0x41, 0x80, 0xa5, 0xcc, 0x76, 0xa1, 0x9e, 0xfb, 0xd0, 0x3b,
+	0xc3, 0x87, 0xc5, 0xc9, 0xa6, 0x28, 0xcc, 0xd2, 0x14, 0x6d, 0xe7, 0xf9, 0x8b, 0xcd, 0xa5, 0xcf,
+	0x5e, 0x6c, 0x2e, 0x7d, 0xfe, 0x62, 0x73, 0xe9, 0x93, 0xf1, 0xa6, 0xf5, 0x7c, 0xbc, 0x69, 0x7d,
+	0x36, 0xde, 0xb4, 0x3e, 0x1f, 0x6f, 0x5a, 0xff, 0x1a, 0x6f, 0x5a, 0x9f, 0xfe, 0x7b, 0x73, 0xe9,
+	0x83, 0x6a, 0xb2, 0xff, 0xff, 0x0d, 0x00, 0x37, 0x2c, 0x51, 0xa3, 0xa3, 0x19, 0x00, 0x00,
 }
@@ -335,6 +335,7 @@ type ArtifactLocation struct {
 	File      *FileArtifact         `json:"file,omitempty" protobuf:"bytes,3,opt,name=file"`
 	URL       *URLArtifact          `json:"url,omitempty" protobuf:"bytes,4,opt,name=url"`
 	Configmap *ConfigmapArtifact    `json:"configmap,omitempty" protobuf:"bytes,5,opt,name=configmap"`
+	Tarball   *TarballArtifact      `json:"tarball,omitempty" protobuf:"bytes,6,opt,name=tarball"`
 }
 
 // ConfigmapArtifact contains information about artifact in k8 configmap
@@ -358,9 +359,21 @@ type URLArtifact struct {
 	VerifyCert bool   `json:"verifyCert,omitempty" protobuf:"bytes,2,opt,name=verifyCert"`
 }
 
+// TarballArtifact contains information about a file within a gzipped tarball at an http endpoint.
+type TarballArtifact struct {
+	// URL of the .tar.gz archive
+	URL string `json:"url" protobuf:"bytes,1,name=url"`
+	// Path of the file within the archive which contains trigger resource definition
+	Path string `json:"path" protobuf:"bytes,2,name=path"`
+	// VerifyCert decides whether the server certificate is verified. It is always verified when AuthHeader is set
+	VerifyCert bool `json:"verifyCert,omitempty" protobuf:"varint,3,opt,name=verifyCert"`
+	// AuthHeader refers to a secret whose value is sent as the Authorization header
+	AuthHeader *corev1.SecretKeySelector `json:"authHeader,omitempty" protobuf:"bytes,4,opt,name=authHeader"`
+}
+
 // HasLocation whether or not an artifact has a location defined
 func (a *ArtifactLocation) HasLocation() bool {
-	return a.S3 != nil || a.Inline != nil || a.File != nil || a.URL != nil
+	return a.S3 != nil || a.Inline != nil || a.File != nil || a.URL != nil || a.Configmap != nil || a.Tarball != nil
 }
 
 // IsComplete determines if the node has reached an end state
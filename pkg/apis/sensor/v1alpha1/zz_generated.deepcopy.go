@@ -53,6 +53,11 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(ConfigmapArtifact)
 		**out = **in
 	}
+	if in.Tarball != nil {
+		in, out := &in.Tarball, &out.Tarball
+		*out = new(TarballArtifact)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -452,6 +457,27 @@ func (in *SensorStatus) DeepCopy() *SensorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TarballArtifact) DeepCopyInto(out *TarballArtifact) {
+	*out = *in
+	if in.AuthHeader != nil {
+		in, out := &in.AuthHeader, &out.AuthHeader
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TarballArtifact.
+func (in *TarballArtifact) DeepCopy() *TarballArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(TarballArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimeFilter) DeepCopyInto(out *TimeFilter) {
 	*out = *in
@@ -156,11 +156,7 @@ func (sec *sensorExecutionCtx) processTriggers() {
 // execute the trigger
 func (sec *sensorExecutionCtx) executeTrigger(trigger v1alpha1.Trigger) error {
 	if trigger.Resource != nil {
-		creds, err := store.GetCredentials(sec.kubeClient, sec.sensor.Namespace, &trigger.Resource.Source)
-		if err != nil {
-			return err
-		}
-		reader, err := store.GetArtifactReader(&trigger.Resource.Source, creds)
+		reader, err := store.NewArtifactReader(sec.kubeClient, sec.sensor.Namespace, &trigger.Resource.Source)
 		if err != nil {
 			return err
 		}
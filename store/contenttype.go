@@ -0,0 +1,55 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+const (
+	// ContentTypeYAML is the content type of YAML artifacts
+	ContentTypeYAML = "application/x-yaml"
+	// ContentTypeJSON is the content type of JSON artifacts
+	ContentTypeJSON = "application/json"
+)
+
+// extensionContentTypes maps the file extensions which http.DetectContentType reports as plain text
+var extensionContentTypes = map[string]string{
+	".yaml": ContentTypeYAML,
+	".yml":  ContentTypeYAML,
+	".json": ContentTypeJSON,
+}
+
+// ReadWithContentType reads the artifact and returns its content along with the detected content type
+func ReadWithContentType(reader ArtifactReader) ([]byte, string, error) {
+	content, err := reader.Read()
+	if err != nil {
+		return nil, "", err
+	}
+	return content, DetectContentType(artifactName(reader), content), nil
+}
+
+// DetectContentType returns the content type of the artifact. The extension of name takes precedence
+// and the content is sniffed with http.DetectContentType otherwise.
+func DetectContentType(name string, content []byte) string {
+	if contentType, ok := extensionContentTypes[strings.ToLower(path.Ext(name))]; ok {
+		return contentType
+	}
+	return http.DetectContentType(content)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+const jsonArtifact = `{"apiVersion": "argoproj.io/v1alpha1", "kind": "Workflow"}`
+
+func TestReadWithContentType(t *testing.T) {
+	dir, err := ioutil.TempDir("", "argo-events-temp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	binary := gzipContent(t, workflowv1alpha1)
+	fixtures := map[string]struct {
+		content     []byte
+		contentType string
+	}{
+		"wf.yaml":    {[]byte(workflowv1alpha1), ContentTypeYAML},
+		"wf.yml":     {[]byte(workflowv1alpha1), ContentTypeYAML},
+		"wf.json":    {[]byte(jsonArtifact), ContentTypeJSON},
+		"wf.yaml.gz": {binary, "application/x-gzip"},
+		"wf-archive": {binary, "application/x-gzip"},
+	}
+	for name, fixture := range fixtures {
+		filePath := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(filePath, fixture.content, 0600); err != nil {
+			t.Fatal(err)
+		}
+		reader, err := NewFileReader(&v1alpha1.FileArtifact{Path: filePath})
+		assert.Nil(t, err)
+		content, contentType, err := ReadWithContentType(reader)
+		assert.Nil(t, err, name)
+		assert.Equal(t, fixture.content, content, name)
+		assert.Equal(t, fixture.contentType, contentType, name)
+	}
+
+	// decompressed artifacts are detected by the name without the compression extension
+	fileReader, err := NewFileReader(&v1alpha1.FileArtifact{Path: filepath.Join(dir, "wf.yaml.gz")})
+	assert.Nil(t, err)
+	decompressReader, err := NewDecompressReader(fileReader, CompressionAuto)
+	assert.Nil(t, err)
+	content, contentType, err := ReadWithContentType(decompressReader)
+	assert.Nil(t, err)
+	assert.Equal(t, workflowv1alpha1, string(content))
+	assert.Equal(t, ContentTypeYAML, contentType)
+
+	// missing file
+	reader, err := NewFileReader(&v1alpha1.FileArtifact{Path: filepath.Join(dir, "unknown.yaml")})
+	assert.Nil(t, err)
+	_, _, err = ReadWithContentType(reader)
+	assert.NotNil(t, err)
+
+	// the query of a url is ignored
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(jsonArtifact))
+	}))
+	defer ts.Close()
+	reader, err = NewURLReader(&v1alpha1.URLArtifact{Path: ts.URL + "/wf.json?raw=true"})
+	assert.Nil(t, err)
+	_, contentType, err = ReadWithContentType(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, ContentTypeJSON, contentType)
+}
+
+func TestArtifactName(t *testing.T) {
+	reader, err := NewS3Reader(&apicommon.S3Artifact{
+		Endpoint: "localhost:9000",
+		Bucket: &apicommon.S3Bucket{
+			Name: "test-bucket",
+			Key:  "manifests/wf.json",
+		},
+	}, &Credentials{})
+	assert.Nil(t, err)
+	assert.Equal(t, "manifests/wf.json", artifactName(reader))
+	assert.Equal(t, ContentTypeJSON, DetectContentType(artifactName(reader), []byte(jsonArtifact)))
+
+	reader, err = NewConfigMapReader(nil, &v1alpha1.ConfigmapArtifact{Name: "wf-configmap", Key: "wf.yaml"})
+	assert.Nil(t, err)
+	assert.Equal(t, "wf.yaml", artifactName(reader))
+
+	reader, err = NewTarballReader(&v1alpha1.TarballArtifact{URL: "http://localhost/repo.tar.gz", Path: "manifests/wf.yaml"}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "manifests/wf.yaml", artifactName(reader))
+
+	inline := workflowv1alpha1
+	reader, err = NewInlineReader(&inline)
+	assert.Nil(t, err)
+	assert.Equal(t, "", artifactName(reader))
+}
+
+func TestDetectContentType(t *testing.T) {
+	assert.Equal(t, ContentTypeYAML, DetectContentType("manifests/WF.YAML", []byte(workflowv1alpha1)))
+	assert.Equal(t, ContentTypeJSON, DetectContentType("wf.json", []byte(jsonArtifact)))
+	assert.Equal(t, "text/plain; charset=utf-8", DetectContentType("", []byte(workflowv1alpha1)))
+	assert.Equal(t, "application/octet-stream", DetectContentType("wf.bin", []byte{0x00, 0x01, 0x02, 0x03}))
+}
@@ -29,8 +29,9 @@ import (
 
 // Credentials contains the information necessary to access the artifact
 type Credentials struct {
-	accessKey string
-	secretKey string
+	accessKey  string
+	secretKey  string
+	authHeader string
 }
 
 // GetCredentials for this artifact
@@ -49,6 +50,15 @@ func GetCredentials(kubeClient kubernetes.Interface, namespace string, art *v1al
 			secretKey: secretKey,
 		}, nil
 	}
+	if art.Tarball != nil && art.Tarball.AuthHeader != nil {
+		authHeader, err := GetSecrets(kubeClient, namespace, art.Tarball.AuthHeader.Name, art.Tarball.AuthHeader.Key)
+		if err != nil {
+			return nil, err
+		}
+		return &Credentials{
+			authHeader: authHeader,
+		}, nil
+	}
 
 	return nil, nil
 }
@@ -68,6 +68,27 @@ func TestGetCredentials(t *testing.T) {
 	assert.NotNil(t, creds)
 	assert.Equal(t, "token", creds.accessKey)
 	assert.Equal(t, "value", creds.secretKey)
+
+	// creds should be nil for tarball artifact without auth header
+	tarballArtifact := &v1alpha1.ArtifactLocation{
+		Tarball: &v1alpha1.TarballArtifact{
+			URL:  "http://localhost/repo.tar.gz",
+			Path: "wf.yaml",
+		},
+	}
+	creds, err = GetCredentials(fakeClient, "testing", tarballArtifact)
+	assert.Nil(t, err)
+	assert.Nil(t, creds)
+
+	// succeed for tarball artifact with auth header
+	tarballArtifact.Tarball.AuthHeader = &apiv1.SecretKeySelector{
+		LocalObjectReference: apiv1.LocalObjectReference{Name: "test"},
+		Key:                  "access",
+	}
+	creds, err = GetCredentials(fakeClient, "testing", tarballArtifact)
+	assert.Nil(t, err)
+	assert.NotNil(t, creds)
+	assert.Equal(t, "token", creds.authHeader)
 }
 
 func TestGetSecrets(t *testing.T) {
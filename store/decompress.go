@@ -0,0 +1,113 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Compression is the compression format of an artifact
+type Compression string
+
+const (
+	// CompressionAuto detects the compression format from the file extension of the artifact
+	CompressionAuto Compression = ""
+	// CompressionNone returns the artifact content as is
+	CompressionNone Compression = "none"
+	// CompressionGzip decompresses gzip artifacts
+	CompressionGzip Compression = "gzip"
+	// CompressionBzip2 decompresses bzip2 artifacts
+	CompressionBzip2 Compression = "bzip2"
+)
+
+// extensionCompressions maps file extensions to the compression format they imply
+var extensionCompressions = map[string]Compression{
+	".gz":  CompressionGzip,
+	".bz2": CompressionBzip2,
+}
+
+// DecompressReader implements the ArtifactReader interface by decompressing the content of another ArtifactReader
+type DecompressReader struct {
+	reader      ArtifactReader
+	compression Compression
+}
+
+// NewDecompressReader creates a new ArtifactReader which decompresses the content read by reader.
+// With CompressionAuto the format is detected from the file extension, and content without a known extension is returned as is.
+func NewDecompressReader(reader ArtifactReader, compression Compression) (ArtifactReader, error) {
+	if reader == nil {
+		return nil, errors.New("ArtifactReader cannot be empty")
+	}
+	if compression == CompressionAuto {
+		compression = CompressionNone
+		if c, ok := extensionCompressions[strings.ToLower(path.Ext(artifactName(reader)))]; ok {
+			compression = c
+		}
+	}
+	switch compression {
+	case CompressionNone, CompressionGzip, CompressionBzip2:
+	default:
+		return nil, fmt.Errorf("unsupported compression %s", compression)
+	}
+	return &DecompressReader{
+		reader:      reader,
+		compression: compression,
+	}, nil
+}
+
+func (reader *DecompressReader) Read() ([]byte, error) {
+	content, err := reader.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	var r io.Reader
+	switch reader.compression {
+	case CompressionGzip:
+		gzr, err := gzip.NewReader(bytes.NewReader(content))
+		if err != nil {
+			log.Warnf("failed to decompress gzip artifact: %s", err)
+			return nil, fmt.Errorf("failed to decompress gzip artifact: %s", err)
+		}
+		defer gzr.Close()
+		r = gzr
+	case CompressionBzip2:
+		r = bzip2.NewReader(bytes.NewReader(content))
+	default:
+		return content, nil
+	}
+	log.Debugf("decompressing %s artifact", reader.compression)
+	content, err = ioutil.ReadAll(io.LimitReader(r, maxArtifactSize+1))
+	if err != nil {
+		log.Warnf("failed to decompress %s artifact: %s", reader.compression, err)
+		return nil, fmt.Errorf("failed to decompress %s artifact: %s", reader.compression, err)
+	}
+	if int64(len(content)) > maxArtifactSize {
+		log.Warnf("decompressed artifact exceeds %d bytes", maxArtifactSize)
+		return nil, ErrArtifactTooLarge
+	}
+	return content, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+const compressedContent = "apiVersion: argoproj.io/v1alpha1\nkind: Workflow\n"
+
+// bzip2Content is compressedContent compressed with bzip2
+var bzip2Content = []byte("\x42\x5a\x68\x39\x31\x41\x59\x26\x53\x59\x99\xdb\x8e\x2b\x00\x00\x07\x5b\x80\x00\x10\x40\x01\xa0\x10\x01\x80\x27\xfd\xd9\x80\x20\x00\x31\x40\x00\xd0\x32\x64\x11\x4f\x53\x32\x4d\x30\x13\x0d\xaa\x04\x52\xbe\xa4\x29\xbc\xcb\x3e\xcd\xc9\x6d\x33\x17\x78\x16\x48\xf2\xef\xa9\x21\xb7\x01\x2a\x99\xcc\x78\x1f\xf8\xbb\x92\x29\xc2\x84\x84\xce\xdc\x71\x58")
+
+func gzipContent(t *testing.T, content string) []byte {
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	_, err := gzw.Write([]byte(content))
+	assert.Nil(t, err)
+	assert.Nil(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func TestDecompressReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "argo-events-temp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	gzipped := gzipContent(t, compressedContent)
+	tests := []struct {
+		name        string
+		content     []byte
+		compression Compression
+		expected    []byte
+	}{
+		{"wf.yaml.gz", gzipped, CompressionAuto, []byte(compressedContent)},
+		{"wf.yaml.GZ", gzipped, CompressionAuto, []byte(compressedContent)},
+		{"wf.yaml.bz2", bzip2Content, CompressionAuto, []byte(compressedContent)},
+		{"wf.yaml", []byte(compressedContent), CompressionAuto, []byte(compressedContent)},
+		{"wf-gzip", gzipped, CompressionGzip, []byte(compressedContent)},
+		{"wf-bzip2", bzip2Content, CompressionBzip2, []byte(compressedContent)},
+		{"raw.yaml.gz", gzipped, CompressionNone, gzipped},
+	}
+	for _, test := range tests {
+		filePath := filepath.Join(dir, test.name)
+		if err := ioutil.WriteFile(filePath, test.content, 0600); err != nil {
+			t.Fatal(err)
+		}
+		fileReader, err := NewFileReader(&v1alpha1.FileArtifact{Path: filePath})
+		assert.Nil(t, err)
+		reader, err := NewDecompressReader(fileReader, test.compression)
+		assert.Nil(t, err, test.name)
+		content, err := reader.Read()
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expected, content, test.name)
+	}
+
+	// corrupt streams
+	for name, content := range map[string][]byte{
+		"corrupt.yaml.gz":  []byte("not gzip"),
+		"corrupt.yaml.bz2": []byte("not bzip2"),
+		"truncated.gz":     gzipped[:len(gzipped)-8],
+	} {
+		filePath := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(filePath, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+		fileReader, err := NewFileReader(&v1alpha1.FileArtifact{Path: filePath})
+		assert.Nil(t, err)
+		reader, err := NewDecompressReader(fileReader, CompressionAuto)
+		assert.Nil(t, err)
+		_, err = reader.Read()
+		assert.NotNil(t, err, name)
+		if err != nil {
+			assert.True(t, strings.HasPrefix(err.Error(), "failed to decompress"), err.Error())
+		}
+	}
+
+	// unsupported compression
+	fileReader, err := NewFileReader(&v1alpha1.FileArtifact{Path: filepath.Join(dir, "wf.yaml")})
+	assert.Nil(t, err)
+	_, err = NewDecompressReader(fileReader, Compression("zstd"))
+	assert.EqualError(t, err, "unsupported compression zstd")
+
+	// missing reader
+	_, err = NewDecompressReader(nil, CompressionGzip)
+	assert.NotNil(t, err)
+}
+
+func TestDecompressReaderLimit(t *testing.T) {
+	defer func(size int64) {
+		maxArtifactSize = size
+	}(maxArtifactSize)
+	maxArtifactSize = 16
+
+	inline := string(gzipContent(t, strings.Repeat("a", 17)))
+	inlineReader, err := NewInlineReader(&inline)
+	assert.Nil(t, err)
+	reader, err := NewDecompressReader(inlineReader, CompressionGzip)
+	assert.Nil(t, err)
+	_, err = reader.Read()
+	assert.Equal(t, ErrArtifactTooLarge, err)
+}
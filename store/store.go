@@ -17,11 +17,16 @@ limitations under the License.
 package store
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+	"path"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 
 	// import packages for the universal deserializer
@@ -48,6 +53,12 @@ type ArtifactReader interface {
 	Read() ([]byte, error)
 }
 
+// ErrArtifactTooLarge is returned when a decompressed or extracted artifact exceeds maxArtifactSize
+var ErrArtifactTooLarge = errors.New("artifact exceeds maximum size")
+
+// maxArtifactSize is the maximum size in bytes of a decompressed or extracted artifact
+var maxArtifactSize int64 = 10 * 1024 * 1024
+
 // FetchArtifact from the location, decode it using explicit types, and unstructure it
 func FetchArtifact(reader ArtifactReader, gvk ss_v1alpha1.GroupVersionKind) (*unstructured.Unstructured, error) {
 	var err error
@@ -59,8 +70,17 @@ func FetchArtifact(reader ArtifactReader, gvk ss_v1alpha1.GroupVersionKind) (*un
 	return decodeAndUnstructure(obj, gvk)
 }
 
+// NewArtifactReader resolves the credentials for the location and returns the ArtifactReader for it
+func NewArtifactReader(kubeClient kubernetes.Interface, namespace string, loc *ss_v1alpha1.ArtifactLocation) (ArtifactReader, error) {
+	creds, err := GetCredentials(kubeClient, namespace, loc)
+	if err != nil {
+		return nil, err
+	}
+	return GetArtifactReader(kubeClient, loc, creds)
+}
+
 // GetArtifactReader returns the ArtifactReader for this location
-func GetArtifactReader(loc *ss_v1alpha1.ArtifactLocation, creds *Credentials) (ArtifactReader, error) {
+func GetArtifactReader(kubeClient kubernetes.Interface, loc *ss_v1alpha1.ArtifactLocation, creds *Credentials) (ArtifactReader, error) {
 	if loc.S3 != nil {
 		return NewS3Reader(loc.S3, creds)
 	} else if loc.Inline != nil {
@@ -69,6 +89,10 @@ func GetArtifactReader(loc *ss_v1alpha1.ArtifactLocation, creds *Credentials) (A
 		return NewFileReader(loc.File)
 	} else if loc.URL != nil {
 		return NewURLReader(loc.URL)
+	} else if loc.Tarball != nil {
+		return NewTarballReader(loc.Tarball, creds)
+	} else if loc.Configmap != nil {
+		return NewConfigMapReader(kubeClient, loc.Configmap)
 	}
 	return nil, fmt.Errorf("unknown artifact location: %v", *loc)
 }
@@ -91,3 +115,34 @@ func decodeAndUnstructure(b []byte, gvk ss_v1alpha1.GroupVersionKind) (*unstruct
 	}
 	return &unstructured.Unstructured{Object: uObj}, nil
 }
+
+// artifactName returns the file name of the artifact read by reader, if it has one.
+// The compression extension is trimmed from the name of decompressed artifacts.
+func artifactName(reader ArtifactReader) string {
+	switch r := reader.(type) {
+	case *FileReader:
+		return r.fileArtifact.Path
+	case *URLReader:
+		u, err := url.Parse(r.urlArtifact.Path)
+		if err != nil {
+			return ""
+		}
+		return u.Path
+	case *S3Reader:
+		if r.s3.Bucket == nil {
+			return ""
+		}
+		return r.s3.Bucket.Key
+	case *ConfigMapReader:
+		return r.configmapArtifact.Key
+	case *TarballReader:
+		return r.tarballArtifact.Path
+	case *DecompressReader:
+		name := artifactName(r.reader)
+		if _, ok := extensionCompressions[strings.ToLower(path.Ext(name))]; ok {
+			return strings.TrimSuffix(name, path.Ext(name))
+		}
+		return name
+	}
+	return ""
+}
@@ -20,8 +20,12 @@ import (
 	"io/ioutil"
 	"testing"
 
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 type FakeWorkflowArtifactReader struct{}
@@ -50,7 +54,98 @@ func TestGetArtifactReader(t *testing.T) {
 		accessKey: "access",
 		secretKey: "secret",
 	}
-	_, err := GetArtifactReader(location, creds)
+	_, err := GetArtifactReader(fake.NewSimpleClientset(), location, creds)
+	assert.NotNil(t, err)
+
+	// test configmap location
+	location = &v1alpha1.ArtifactLocation{
+		Configmap: &v1alpha1.ConfigmapArtifact{
+			Name:      "wf-configmap",
+			Namespace: "testing",
+			Key:       "wf",
+		},
+	}
+	reader, err := GetArtifactReader(fake.NewSimpleClientset(), location, nil)
+	assert.Nil(t, err)
+	assert.IsType(t, &ConfigMapReader{}, reader)
+}
+
+func TestNewArtifactReader(t *testing.T) {
+	fakeClient := fake.NewSimpleClientset()
+	_, err := fakeClient.CoreV1().Secrets("testing").Create(&apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "testing",
+		},
+		Data: map[string][]byte{"access": []byte("token"), "secret": []byte("value")},
+	})
+	assert.Nil(t, err)
+
+	inline := "inline"
+	s3Location := &v1alpha1.ArtifactLocation{
+		S3: &apicommon.S3Artifact{
+			Endpoint: "localhost:9000",
+			AccessKey: &apiv1.SecretKeySelector{
+				LocalObjectReference: apiv1.LocalObjectReference{Name: "test"},
+				Key:                  "access",
+			},
+			SecretKey: &apiv1.SecretKeySelector{
+				LocalObjectReference: apiv1.LocalObjectReference{Name: "test"},
+				Key:                  "secret",
+			},
+			Bucket: &apicommon.S3Bucket{
+				Name: "test-bucket",
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		location *v1alpha1.ArtifactLocation
+		reader   ArtifactReader
+	}{
+		{"s3", s3Location, &S3Reader{}},
+		{"inline", &v1alpha1.ArtifactLocation{Inline: &inline}, &InlineReader{}},
+		{"file", &v1alpha1.ArtifactLocation{File: &v1alpha1.FileArtifact{Path: "/tmp/wf.yaml"}}, &FileReader{}},
+		{"url", &v1alpha1.ArtifactLocation{URL: &v1alpha1.URLArtifact{Path: "http://localhost/wf.yaml"}}, &URLReader{}},
+		{
+			"tarball",
+			&v1alpha1.ArtifactLocation{
+				Tarball: &v1alpha1.TarballArtifact{
+					URL:  "http://localhost/repo.tar.gz",
+					Path: "wf.yaml",
+					AuthHeader: &apiv1.SecretKeySelector{
+						LocalObjectReference: apiv1.LocalObjectReference{Name: "test"},
+						Key:                  "access",
+					},
+				},
+			},
+			&TarballReader{},
+		},
+		{
+			"configmap",
+			&v1alpha1.ArtifactLocation{
+				Configmap: &v1alpha1.ConfigmapArtifact{
+					Name:      "wf-configmap",
+					Namespace: "testing",
+					Key:       "wf",
+				},
+			},
+			&ConfigMapReader{},
+		},
+	}
+	for _, test := range tests {
+		reader, err := NewArtifactReader(fakeClient, "testing", test.location)
+		assert.Nil(t, err, test.name)
+		assert.NotNil(t, reader, test.name)
+		assert.IsType(t, test.reader, reader, test.name)
+	}
+
+	// test unknown failure
+	_, err = NewArtifactReader(fakeClient, "testing", &v1alpha1.ArtifactLocation{})
+	assert.NotNil(t, err)
+
+	// test missing credentials failure
+	_, err = NewArtifactReader(fake.NewSimpleClientset(), "testing", s3Location)
 	assert.NotNil(t, err)
 }
 
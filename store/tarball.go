@@ -0,0 +1,164 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrFileNotInArchive is returned when the tarball does not contain the requested file
+	ErrFileNotInArchive = errors.New("file not found in archive")
+	// ErrArchiveTooLarge is returned when the decompressed tarball exceeds maxArchiveSize
+	ErrArchiveTooLarge = errors.New("archive exceeds maximum size")
+)
+
+var (
+	// maxArchiveSize is the maximum number of decompressed bytes read while searching the archive
+	maxArchiveSize int64 = 100 * 1024 * 1024
+	// tarballReadTimeout bounds the time spent downloading and extracting the tarball
+	tarballReadTimeout = time.Minute
+)
+
+// MalformedArchiveError is returned when the tarball cannot be decompressed or unpacked
+type MalformedArchiveError struct {
+	Err error
+}
+
+func (e *MalformedArchiveError) Error() string {
+	return "malformed archive: " + e.Err.Error()
+}
+
+// TarballReader implements the ArtifactReader interface for files within gzipped tarballs at url endpoints
+type TarballReader struct {
+	tarballArtifact *v1alpha1.TarballArtifact
+	creds           *Credentials
+}
+
+// NewTarballReader creates a new ArtifactReader for files within gzipped tarballs at URL endpoints.
+func NewTarballReader(tarballArtifact *v1alpha1.TarballArtifact, creds *Credentials) (ArtifactReader, error) {
+	if tarballArtifact == nil {
+		return nil, errors.New("TarballArtifact cannot be empty")
+	}
+	return &TarballReader{
+		tarballArtifact: tarballArtifact,
+		creds:           creds,
+	}, nil
+}
+
+func (reader *TarballReader) Read() ([]byte, error) {
+	log.Debugf("reading tarballArtifact %s from %s", reader.tarballArtifact.Path, reader.tarballArtifact.URL)
+	req, err := http.NewRequest(http.MethodGet, reader.tarballArtifact.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	// certificates are always verified when credentials are sent
+	insecureSkipVerify := !reader.tarballArtifact.VerifyCert
+	if reader.creds != nil && reader.creds.authHeader != "" {
+		req.Header.Set("Authorization", reader.creds.authHeader)
+		insecureSkipVerify = false
+	}
+	client := &http.Client{
+		Timeout: tarballReadTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Warnf("failed to read url %s: %s", reader.tarballArtifact.URL, err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.Warnf("failed to read %s. status code: %d", reader.tarballArtifact.URL, resp.StatusCode)
+		return nil, fmt.Errorf("status code %d", resp.StatusCode)
+	}
+	return extractFile(resp.Body, reader.tarballArtifact.Path)
+}
+
+// extractFile returns the content of the regular file at filePath within the gzipped tarball
+func extractFile(r io.Reader, filePath string) ([]byte, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		log.Warnf("failed to decompress archive: %s", err)
+		return nil, &MalformedArchiveError{err}
+	}
+	defer gzr.Close()
+
+	target := cleanArchivePath(filePath)
+	lr := &io.LimitedReader{R: gzr, N: maxArchiveSize}
+	tr := tar.NewReader(lr)
+	for {
+		header, err := tr.Next()
+		// the limit can run out at an entry boundary, in which case Next reports io.EOF
+		if err != nil && lr.N <= 0 {
+			log.Warnf("archive exceeds %d bytes", maxArchiveSize)
+			return nil, ErrArchiveTooLarge
+		}
+		if err == io.EOF {
+			return nil, ErrFileNotInArchive
+		}
+		if err != nil {
+			log.Warnf("failed to unpack archive: %s", err)
+			return nil, &MalformedArchiveError{err}
+		}
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+		if cleanArchivePath(header.Name) != target {
+			continue
+		}
+		if header.Size > maxArtifactSize {
+			log.Warnf("%s exceeds %d bytes", filePath, maxArtifactSize)
+			return nil, ErrArtifactTooLarge
+		}
+		content, err := ioutil.ReadAll(io.LimitReader(tr, maxArtifactSize+1))
+		if err != nil {
+			if lr.N <= 0 {
+				log.Warnf("archive exceeds %d bytes", maxArchiveSize)
+				return nil, ErrArchiveTooLarge
+			}
+			log.Warnf("failed to read %s from archive: %s", filePath, err)
+			return nil, &MalformedArchiveError{err}
+		}
+		if int64(len(content)) > maxArtifactSize {
+			log.Warnf("%s exceeds %d bytes", filePath, maxArtifactSize)
+			return nil, ErrArtifactTooLarge
+		}
+		return content, nil
+	}
+}
+
+// cleanArchivePath normalizes entry names such as "./manifests/wf.yaml" and "/manifests/wf.yaml"
+func cleanArchivePath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
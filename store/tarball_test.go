@@ -0,0 +1,193 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTarball(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})
+		assert.Nil(t, err)
+		_, err = tw.Write([]byte(content))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func TestTarballReader(t *testing.T) {
+	tarball := newTarball(t, map[string]string{
+		"./README.md":                  "readme",
+		"./manifests/workflow.yaml":    workflowv1alpha1,
+		"./manifests/other/other.yaml": "other",
+	})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/repo.tar.gz":
+			w.Write(tarball)
+		case "/malformed.tar.gz":
+			w.Write([]byte("not a tarball"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	creds := &Credentials{authHeader: "Bearer token"}
+
+	_, err := NewTarballReader(nil, creds)
+	assert.NotNil(t, err)
+
+	// read the file from the archive
+	reader, err := NewTarballReader(&v1alpha1.TarballArtifact{URL: ts.URL + "/repo.tar.gz", Path: "manifests/workflow.yaml"}, creds)
+	assert.Nil(t, err)
+	data, err := reader.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, workflowv1alpha1, string(data))
+
+	// leading slash in path
+	reader, err = NewTarballReader(&v1alpha1.TarballArtifact{URL: ts.URL + "/repo.tar.gz", Path: "/manifests/workflow.yaml"}, creds)
+	assert.Nil(t, err)
+	data, err = reader.Read()
+	assert.Nil(t, err)
+	assert.Equal(t, workflowv1alpha1, string(data))
+
+	// file not in archive
+	reader, err = NewTarballReader(&v1alpha1.TarballArtifact{URL: ts.URL + "/repo.tar.gz", Path: "manifests/unknown.yaml"}, creds)
+	assert.Nil(t, err)
+	_, err = reader.Read()
+	assert.Equal(t, ErrFileNotInArchive, err)
+
+	// malformed archive
+	reader, err = NewTarballReader(&v1alpha1.TarballArtifact{URL: ts.URL + "/malformed.tar.gz", Path: "manifests/workflow.yaml"}, creds)
+	assert.Nil(t, err)
+	_, err = reader.Read()
+	assert.IsType(t, &MalformedArchiveError{}, err)
+
+	// missing auth header
+	reader, err = NewTarballReader(&v1alpha1.TarballArtifact{URL: ts.URL + "/repo.tar.gz", Path: "manifests/workflow.yaml"}, nil)
+	assert.Nil(t, err)
+	_, err = reader.Read()
+	assert.EqualError(t, err, "status code 401")
+
+	// unknown archive
+	reader, err = NewTarballReader(&v1alpha1.TarballArtifact{URL: ts.URL + "/unknown.tar.gz", Path: "manifests/workflow.yaml"}, creds)
+	assert.Nil(t, err)
+	_, err = reader.Read()
+	assert.EqualError(t, err, "status code 404")
+}
+
+func TestTarballReaderVerifiesCertWithCredentials(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+	tarballArtifact := &v1alpha1.TarballArtifact{URL: ts.URL + "/repo.tar.gz", Path: "manifests/workflow.yaml"}
+
+	// without credentials the self-signed certificate is accepted
+	reader, err := NewTarballReader(tarballArtifact, nil)
+	assert.Nil(t, err)
+	_, err = reader.Read()
+	assert.EqualError(t, err, "status code 401")
+
+	// with credentials the certificate is verified even though verifyCert is false
+	reader, err = NewTarballReader(tarballArtifact, &Credentials{authHeader: "Bearer token"})
+	assert.Nil(t, err)
+	_, err = reader.Read()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "x509")
+}
+
+func TestExtractFileLimits(t *testing.T) {
+	defer func(artifactSize, archiveSize int64) {
+		maxArtifactSize, maxArchiveSize = artifactSize, archiveSize
+	}(maxArtifactSize, maxArchiveSize)
+	maxArtifactSize, maxArchiveSize = 16, 64*1024
+
+	tarball := newTarball(t, map[string]string{
+		"small.yaml": "small",
+		"large.yaml": strings.Repeat("a", 17),
+	})
+	content, err := extractFile(bytes.NewReader(tarball), "small.yaml")
+	assert.Nil(t, err)
+	assert.Equal(t, "small", string(content))
+
+	_, err = extractFile(bytes.NewReader(tarball), "large.yaml")
+	assert.Equal(t, ErrArtifactTooLarge, err)
+
+	tarball = newTarball(t, map[string]string{
+		"padding": strings.Repeat("a", 128*1024),
+	})
+	_, err = extractFile(bytes.NewReader(tarball), "small.yaml")
+	assert.Equal(t, ErrArchiveTooLarge, err)
+
+	// the limit runs out at an entry boundary
+	maxArchiveSize = 4096
+	files := map[string]string{}
+	for i := 0; i < 20; i++ {
+		files[fmt.Sprintf("file-%d", i)] = "a"
+	}
+	_, err = extractFile(bytes.NewReader(newTarball(t, files)), "small.yaml")
+	assert.Equal(t, ErrArchiveTooLarge, err)
+}
+
+func TestTarballReaderTimeout(t *testing.T) {
+	defer func(timeout time.Duration) {
+		tarballReadTimeout = timeout
+	}(tarballReadTimeout)
+	tarballReadTimeout = 50 * time.Millisecond
+
+	stalled := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-stalled
+	}))
+	defer ts.Close()
+	defer close(stalled)
+
+	reader, err := NewTarballReader(&v1alpha1.TarballArtifact{URL: ts.URL + "/repo.tar.gz", Path: "manifests/workflow.yaml"}, nil)
+	assert.Nil(t, err)
+	_, err = reader.Read()
+	assert.NotNil(t, err)
+	if err != nil {
+		assert.Contains(t, err.Error(), "Client.Timeout exceeded")
+	}
+}
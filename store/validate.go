@@ -0,0 +1,78 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+)
+
+// Format is the document format an artifact is validated against
+type Format string
+
+const (
+	// FormatAuto detects the format from the content type of the artifact
+	FormatAuto Format = ""
+	// FormatYAML validates YAML documents
+	FormatYAML Format = "yaml"
+	// FormatJSON validates JSON documents
+	FormatJSON Format = "json"
+)
+
+// contentTypeFormats maps detected content types to the document format they imply
+var contentTypeFormats = map[string]Format{
+	ContentTypeYAML: FormatYAML,
+	ContentTypeJSON: FormatJSON,
+}
+
+// ValidateContentType reads the artifact and checks that its content parses as format,
+// so that a malformed manifest fails early with a parse error.
+func ValidateContentType(reader ArtifactReader, format Format) ([]byte, error) {
+	switch format {
+	case FormatAuto, FormatYAML, FormatJSON:
+	default:
+		return nil, fmt.Errorf("unsupported format %s", format)
+	}
+	content, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if format == FormatAuto {
+		name := artifactName(reader)
+		contentType := DetectContentType(name, content)
+		detected, ok := contentTypeFormats[contentType]
+		if !ok {
+			return nil, fmt.Errorf("unable to detect the format of artifact %q with content type %s", name, contentType)
+		}
+		format = detected
+	}
+	var obj interface{}
+	switch format {
+	case FormatYAML:
+		err = yaml.Unmarshal(content, &obj)
+	case FormatJSON:
+		err = json.Unmarshal(content, &obj)
+	}
+	if err != nil {
+		log.Warnf("artifact is not a valid %s document: %s", format, err)
+		return nil, fmt.Errorf("invalid %s artifact: %s", format, err)
+	}
+	return content, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateContentType(t *testing.T) {
+	dir, err := ioutil.TempDir("", "argo-events-temp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	newReader := func(name string, content []byte) ArtifactReader {
+		filePath := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(filePath, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+		reader, err := NewFileReader(&v1alpha1.FileArtifact{Path: filePath})
+		assert.Nil(t, err)
+		return reader
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		format  Format
+		valid   bool
+	}{
+		{"wf.yaml", workflowv1alpha1, FormatAuto, true},
+		{"wf.yml", workflowv1alpha1, FormatAuto, true},
+		{"wf.json", `{"kind": "Workflow"}`, FormatAuto, true},
+		{"wf-yaml", workflowv1alpha1, FormatYAML, true},
+		{"wf-json", `{"kind": "Workflow"}`, FormatJSON, true},
+		{"invalid.yaml", "kind: [Workflow", FormatAuto, false},
+		{"invalid.json", `{"kind": "Workflow"`, FormatAuto, false},
+		{"invalid-json.yaml", workflowv1alpha1, FormatJSON, false},
+	}
+	for _, test := range tests {
+		content, err := ValidateContentType(newReader(test.name, []byte(test.content)), test.format)
+		if test.valid {
+			assert.Nil(t, err, test.name)
+			assert.Equal(t, test.content, string(content), test.name)
+		} else {
+			assert.NotNil(t, err, test.name)
+			if err != nil {
+				assert.True(t, strings.HasPrefix(err.Error(), "invalid "+string(test.format)), err.Error())
+			}
+		}
+	}
+
+	// the format of decompressed artifacts is detected from the name without the compression extension
+	reader, err := NewDecompressReader(newReader("wf.json.gz", gzipContent(t, `{"kind": "Workflow"}`)), CompressionAuto)
+	assert.Nil(t, err)
+	content, err := ValidateContentType(reader, FormatAuto)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"kind": "Workflow"}`, string(content))
+
+	// unknown extension
+	_, err = ValidateContentType(newReader("wf.txt", []byte(workflowv1alpha1)), FormatAuto)
+	assert.EqualError(t, err, `unable to detect the format of artifact "`+filepath.Join(dir, "wf.txt")+`" with content type text/plain; charset=utf-8`)
+
+	// unsupported format
+	_, err = ValidateContentType(newReader("wf.toml", []byte(workflowv1alpha1)), Format("toml"))
+	assert.EqualError(t, err, "unsupported format toml")
+
+	// read failure
+	reader, err = NewFileReader(&v1alpha1.FileArtifact{Path: filepath.Join(dir, "unknown.yaml")})
+	assert.Nil(t, err)
+	_, err = ValidateContentType(reader, FormatAuto)
+	assert.NotNil(t, err)
+}